@@ -0,0 +1,505 @@
+package btree
+
+import (
+	"cmp"
+	"errors"
+	"iter"
+	"slices"
+)
+
+var ErrNotSorted = errors.New("keys are not in strictly ascending order")
+
+type item[K, V any] struct {
+	key K
+	val V
+}
+
+// cowToken marks which tree owns a node. A tree may only modify nodes
+// carrying its own token and copies any other node first. It must not be
+// zero-sized, otherwise distinct tokens could share an address.
+type cowToken struct {
+	_ byte
+}
+
+type node[K, V any] struct {
+	items    []item[K, V]
+	children []*node[K, V]
+	cow      *cowToken
+}
+
+func (n *node[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// BTree is a sorted map stored in a B-tree of the given minimum degree t:
+// every node except the root holds between t-1 and 2t-1 entries. Wide
+// nodes keep related keys next to each other in memory, which makes the
+// tree much friendlier to caches than one-entry-per-node structures.
+// The zero value is not usable; create one with New, NewOrdered or
+// FromSorted.
+type BTree[K, V any] struct {
+	root   *node[K, V]
+	length int
+	degree int
+	cmp    func(a, b K) int
+	cow    *cowToken
+}
+
+// New returns an empty tree of the given minimum degree ordered by
+// compare. It panics if degree is less than 2.
+func New[K, V any](degree int, compare func(a, b K) int) *BTree[K, V] {
+	if degree < 2 {
+		panic("btree: degree must be at least 2")
+	}
+	return &BTree[K, V]{degree: degree, cmp: compare, cow: new(cowToken)}
+}
+
+// NewOrdered returns an empty tree ordered by the natural order of K.
+func NewOrdered[K cmp.Ordered, V any](degree int) *BTree[K, V] {
+	return New[K, V](degree, cmp.Compare[K])
+}
+
+// FromSorted builds a tree from entries given in strictly ascending key
+// order. The tree is built bottom-up in O(n) with evenly filled nodes,
+// which is much faster than inserting the entries one by one.
+func FromSorted[K, V any](degree int, compare func(a, b K) int, seq iter.Seq2[K, V]) (*BTree[K, V], error) {
+	t := New[K, V](degree, compare)
+
+	var items []item[K, V]
+	for k, v := range seq {
+		if len(items) > 0 && compare(items[len(items)-1].key, k) >= 0 {
+			return nil, ErrNotSorted
+		}
+		items = append(items, item[K, V]{key: k, val: v})
+	}
+
+	if len(items) == 0 {
+		return t, nil
+	}
+
+	// capacity[h] is how many entries fit into a full tree of height h.
+	capacity := []int{0}
+	for capacity[len(capacity)-1] < len(items) {
+		h := len(capacity)
+		capacity = append(capacity, (capacity[h-1]+1)*(t.maxItems()+1)-1)
+	}
+
+	t.root = t.build(items, capacity, len(capacity)-1)
+	t.length = len(items)
+	return t, nil
+}
+
+// build returns a subtree of height h holding items. Items are split
+// evenly between as few children as fit, which keeps every node above
+// its minimum size.
+func (t *BTree[K, V]) build(items []item[K, V], capacity []int, h int) *node[K, V] {
+	n := t.newNode()
+
+	if h == 1 {
+		n.items = slices.Clone(items)
+		return n
+	}
+
+	k := (len(items) + capacity[h-1] + 1) / (capacity[h-1] + 1)
+	per, extra := (len(items)-(k-1))/k, (len(items)-(k-1))%k
+
+	n.items = make([]item[K, V], 0, k-1)
+	n.children = make([]*node[K, V], 0, k)
+	for i := 0; i < k; i++ {
+		size := per
+		if i < extra {
+			size++
+		}
+
+		n.children = append(n.children, t.build(items[:size], capacity, h-1))
+		items = items[size:]
+
+		if i < k-1 {
+			n.items = append(n.items, items[0])
+			items = items[1:]
+		}
+	}
+	return n
+}
+
+func (t *BTree[K, V]) maxItems() int {
+	return 2*t.degree - 1
+}
+
+func (t *BTree[K, V]) minItems() int {
+	return t.degree - 1
+}
+
+func (t *BTree[K, V]) Len() int {
+	return t.length
+}
+
+// Clone returns a copy of the tree in O(1). Both trees share their nodes
+// until one of them writes to a node, which then gets copied lazily.
+func (t *BTree[K, V]) Clone() *BTree[K, V] {
+	// Give both trees fresh tokens so that neither owns the shared nodes.
+	t.cow = new(cowToken)
+	clone := *t
+	clone.cow = new(cowToken)
+	return &clone
+}
+
+func (t *BTree[K, V]) search(n *node[K, V], key K) (int, bool) {
+	return slices.BinarySearchFunc(n.items, key, func(it item[K, V], key K) int {
+		return t.cmp(it.key, key)
+	})
+}
+
+func (t *BTree[K, V]) newNode() *node[K, V] {
+	return &node[K, V]{cow: t.cow}
+}
+
+// mutable returns n itself if t owns it and a private copy otherwise.
+func (t *BTree[K, V]) mutable(n *node[K, V]) *node[K, V] {
+	if n.cow == t.cow {
+		return n
+	}
+
+	c := t.newNode()
+	c.items = slices.Clone(n.items)
+	c.children = slices.Clone(n.children)
+	return c
+}
+
+func (t *BTree[K, V]) mutableChild(n *node[K, V], i int) *node[K, V] {
+	c := t.mutable(n.children[i])
+	n.children[i] = c
+	return c
+}
+
+func (t *BTree[K, V]) Get(key K) (V, bool) {
+	for n := t.root; n != nil; {
+		i, found := t.search(n, key)
+		if found {
+			return n.items[i].val, true
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+
+	var vNil V
+	return vNil, false
+}
+
+// Put stores val under key and reports whether the key is new.
+func (t *BTree[K, V]) Put(key K, val V) bool {
+	it := item[K, V]{key: key, val: val}
+
+	if t.root == nil {
+		t.root = t.newNode()
+		t.root.items = append(t.root.items, it)
+		t.length++
+		return true
+	}
+
+	t.root = t.mutable(t.root)
+	if len(t.root.items) >= t.maxItems() {
+		mid, right := t.split(t.root, t.maxItems()/2)
+		root := t.newNode()
+		root.items = append(root.items, mid)
+		root.children = append(root.children, t.root, right)
+		t.root = root
+	}
+
+	if t.insert(t.root, it) {
+		t.length++
+		return true
+	}
+	return false
+}
+
+// split moves the entries after i into a new node and returns the entry
+// at i together with that node. n must be mutable.
+func (t *BTree[K, V]) split(n *node[K, V], i int) (item[K, V], *node[K, V]) {
+	mid := n.items[i]
+
+	right := t.newNode()
+	right.items = append(right.items, n.items[i+1:]...)
+	clear(n.items[i:])
+	n.items = n.items[:i]
+
+	if !n.leaf() {
+		right.children = append(right.children, n.children[i+1:]...)
+		clear(n.children[i+1:])
+		n.children = n.children[:i+1]
+	}
+
+	return mid, right
+}
+
+// insert adds it below the mutable, non-full node n and reports whether
+// the key is new.
+func (t *BTree[K, V]) insert(n *node[K, V], it item[K, V]) bool {
+	i, found := t.search(n, it.key)
+	if found {
+		n.items[i].val = it.val
+		return false
+	}
+
+	if n.leaf() {
+		n.items = slices.Insert(n.items, i, it)
+		return true
+	}
+
+	if len(n.children[i].items) >= t.maxItems() {
+		mid, right := t.split(t.mutableChild(n, i), t.maxItems()/2)
+		n.items = slices.Insert(n.items, i, mid)
+		n.children = slices.Insert(n.children, i+1, right)
+
+		switch c := t.cmp(it.key, mid.key); {
+		case c == 0:
+			n.items[i].val = it.val
+			return false
+		case c > 0:
+			i++
+		}
+	}
+
+	return t.insert(t.mutableChild(n, i), it)
+}
+
+// Delete removes key and reports whether it was present.
+func (t *BTree[K, V]) Delete(key K) bool {
+	if t.root == nil {
+		return false
+	}
+
+	t.root = t.mutable(t.root)
+	deleted := t.remove(t.root, key)
+
+	if len(t.root.items) == 0 {
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+
+	if deleted {
+		t.length--
+	}
+	return deleted
+}
+
+// remove deletes key below the mutable node n. Before descending it makes
+// sure the child has more than the minimum number of entries, so the
+// deletion never has to walk back up.
+func (t *BTree[K, V]) remove(n *node[K, V], key K) bool {
+	i, found := t.search(n, key)
+
+	if n.leaf() {
+		if found {
+			n.items = slices.Delete(n.items, i, i+1)
+		}
+		return found
+	}
+
+	if len(n.children[i].items) <= t.minItems() {
+		t.grow(n, i)
+		return t.remove(n, key)
+	}
+
+	child := t.mutableChild(n, i)
+	if found {
+		n.items[i] = t.removeMax(child)
+		return true
+	}
+	return t.remove(child, key)
+}
+
+// removeMax deletes and returns the greatest entry below the mutable
+// node n.
+func (t *BTree[K, V]) removeMax(n *node[K, V]) item[K, V] {
+	if n.leaf() {
+		last := n.items[len(n.items)-1]
+		n.items = slices.Delete(n.items, len(n.items)-1, len(n.items))
+		return last
+	}
+
+	i := len(n.children) - 1
+	if len(n.children[i].items) <= t.minItems() {
+		t.grow(n, i)
+		return t.removeMax(n)
+	}
+	return t.removeMax(t.mutableChild(n, i))
+}
+
+// grow gives child i of the mutable node n one more entry, borrowing it
+// through n from a sibling or merging with a sibling if both are minimal.
+func (t *BTree[K, V]) grow(n *node[K, V], i int) {
+	switch {
+	case i > 0 && len(n.children[i-1].items) > t.minItems():
+		child, left := t.mutableChild(n, i), t.mutableChild(n, i-1)
+
+		last := len(left.items) - 1
+		child.items = slices.Insert(child.items, 0, n.items[i-1])
+		n.items[i-1] = left.items[last]
+		left.items = slices.Delete(left.items, last, last+1)
+
+		if !left.leaf() {
+			last := len(left.children) - 1
+			child.children = slices.Insert(child.children, 0, left.children[last])
+			left.children = slices.Delete(left.children, last, last+1)
+		}
+
+	case i < len(n.items) && len(n.children[i+1].items) > t.minItems():
+		child, right := t.mutableChild(n, i), t.mutableChild(n, i+1)
+
+		child.items = append(child.items, n.items[i])
+		n.items[i] = right.items[0]
+		right.items = slices.Delete(right.items, 0, 1)
+
+		if !right.leaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+
+	default:
+		if i >= len(n.items) {
+			i--
+		}
+		child, right := t.mutableChild(n, i), n.children[i+1]
+
+		child.items = append(child.items, n.items[i])
+		child.items = append(child.items, right.items...)
+		child.children = append(child.children, right.children...)
+
+		n.items = slices.Delete(n.items, i, i+1)
+		n.children = slices.Delete(n.children, i+1, i+2)
+	}
+}
+
+// DeleteRange removes every key with lo <= key < hi and returns how many
+// keys were removed. Keys are deleted one at a time, so removing k keys
+// costs O(k log n); it only saves the caller from collecting the keys.
+func (t *BTree[K, V]) DeleteRange(lo, hi K) int {
+	removed := 0
+	for {
+		k, ok := t.ceiling(lo)
+		if !ok || t.cmp(k, hi) >= 0 {
+			return removed
+		}
+		t.Delete(k)
+		removed++
+	}
+}
+
+// ceiling returns the smallest key that is not less than key.
+func (t *BTree[K, V]) ceiling(key K) (K, bool) {
+	var (
+		best  K
+		found bool
+	)
+	for n := t.root; n != nil; {
+		i, exact := t.search(n, key)
+		if exact {
+			return n.items[i].key, true
+		}
+		if i < len(n.items) {
+			best, found = n.items[i].key, true
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	return best, found
+}
+
+func (t *BTree[K, V]) Min() (K, V, bool) {
+	n := t.root
+	for n != nil && !n.leaf() {
+		n = n.children[0]
+	}
+
+	if n == nil {
+		return unpack[K, V](nil)
+	}
+	return unpack(&n.items[0])
+}
+
+func (t *BTree[K, V]) Max() (K, V, bool) {
+	n := t.root
+	for n != nil && !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+
+	if n == nil {
+		return unpack[K, V](nil)
+	}
+	return unpack(&n.items[len(n.items)-1])
+}
+
+// All returns an iterator over all entries in ascending key order.
+func (t *BTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if t.root != nil {
+			walk(t.root, yield)
+		}
+	}
+}
+
+// Range returns an iterator over the entries with lo <= key < hi in
+// ascending key order.
+func (t *BTree[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if t.root != nil {
+			t.walkRange(t.root, lo, hi, yield)
+		}
+	}
+}
+
+func walk[K, V any](n *node[K, V], yield func(K, V) bool) bool {
+	for i, it := range n.items {
+		if !n.leaf() && !walk(n.children[i], yield) {
+			return false
+		}
+		if !yield(it.key, it.val) {
+			return false
+		}
+	}
+
+	if !n.leaf() {
+		return walk(n.children[len(n.items)], yield)
+	}
+	return true
+}
+
+// walkRange returns false once the walk has to stop, either because yield
+// asked for it or because a key reached hi.
+func (t *BTree[K, V]) walkRange(n *node[K, V], lo, hi K, yield func(K, V) bool) bool {
+	i, _ := t.search(n, lo)
+
+	for ; i < len(n.items); i++ {
+		if !n.leaf() && !t.walkRange(n.children[i], lo, hi, yield) {
+			return false
+		}
+		it := n.items[i]
+		if t.cmp(it.key, hi) >= 0 || !yield(it.key, it.val) {
+			return false
+		}
+	}
+
+	if !n.leaf() {
+		return t.walkRange(n.children[len(n.items)], lo, hi, yield)
+	}
+	return true
+}
+
+func unpack[K, V any](it *item[K, V]) (K, V, bool) {
+	if it == nil {
+		var (
+			kNil K
+			vNil V
+		)
+		return kNil, vNil, false
+	}
+	return it.key, it.val, true
+}
@@ -0,0 +1,422 @@
+package btree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers/sll"
+	"github.com/ivdaria/go-containers/containers/treemap"
+)
+
+// checkTree verifies the node sizes, the key order, the cached length and
+// that all leaves are at the same depth.
+func checkTree[K, V any](t *testing.T, b *BTree[K, V]) {
+	t.Helper()
+
+	if b.root == nil {
+		assert.Zero(t, b.Len())
+		return
+	}
+
+	leafDepth := -1
+	var check func(n *node[K, V], depth int) int
+	check = func(n *node[K, V], depth int) int {
+		if n != b.root {
+			assert.GreaterOrEqual(t, len(n.items), b.minItems())
+		}
+		assert.NotEmpty(t, n.items)
+		assert.LessOrEqual(t, len(n.items), b.maxItems())
+
+		for i := 1; i < len(n.items); i++ {
+			assert.Negative(t, b.cmp(n.items[i-1].key, n.items[i].key))
+		}
+
+		if n.leaf() {
+			if leafDepth == -1 {
+				leafDepth = depth
+			}
+			assert.Equal(t, leafDepth, depth)
+			return len(n.items)
+		}
+
+		assert.Len(t, n.children, len(n.items)+1)
+		count := len(n.items)
+		for _, c := range n.children {
+			count += check(c, depth+1)
+		}
+		return count
+	}
+
+	assert.Equal(t, b.Len(), check(b.root, 0))
+}
+
+func keys(b *BTree[int, string]) []int {
+	var got []int
+	for k := range b.All() {
+		got = append(got, k)
+	}
+	return got
+}
+
+func newFrom(degree int, keys ...int) *BTree[int, string] {
+	b := NewOrdered[int, string](degree)
+	for _, k := range keys {
+		b.Put(k, fmt.Sprint(k))
+	}
+	return b
+}
+
+func seqOf(keys []int) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		for _, k := range keys {
+			if !yield(k, fmt.Sprint(k)) {
+				return
+			}
+		}
+	}
+}
+
+func TestBTree_PutGetDelete(t *testing.T) {
+	t.Parallel()
+
+	b := NewOrdered[string, int](2)
+	_, ok := b.Get("a")
+	assert.False(t, ok)
+	assert.False(t, b.Delete("a"))
+
+	assert.True(t, b.Put("b", 2))
+	assert.True(t, b.Put("a", 1))
+	assert.True(t, b.Put("c", 3))
+	assert.False(t, b.Put("a", 10))
+	assert.Equal(t, 3, b.Len())
+
+	v, ok := b.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
+
+	assert.True(t, b.Delete("b"))
+	assert.False(t, b.Delete("b"))
+	_, ok = b.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 2, b.Len())
+	checkTree(t, b)
+
+	assert.Panics(t, func() { NewOrdered[int, int](1) })
+}
+
+func TestBTree_MinMax(t *testing.T) {
+	t.Parallel()
+
+	b := NewOrdered[int, string](2)
+	_, _, ok := b.Min()
+	assert.False(t, ok)
+	_, _, ok = b.Max()
+	assert.False(t, ok)
+
+	for i := 50; i > 0; i-- {
+		b.Put(i, fmt.Sprint(i))
+	}
+
+	k, v, ok := b.Min()
+	assert.True(t, ok)
+	assert.Equal(t, 1, k)
+	assert.Equal(t, "1", v)
+
+	k, _, ok = b.Max()
+	assert.True(t, ok)
+	assert.Equal(t, 50, k)
+}
+
+func TestBTree_Range(t *testing.T) {
+	type testCase struct {
+		name   string
+		lo, hi int
+		want   []int
+	}
+	tests := []testCase{
+		{
+			name: "empty range",
+			lo:   5,
+			hi:   5,
+			want: nil,
+		},
+		{
+			name: "hi is exclusive",
+			lo:   12,
+			hi:   16,
+			want: []int{12, 13, 14, 15},
+		},
+		{
+			name: "bounds outside the keys",
+			lo:   -10,
+			hi:   3,
+			want: []int{0, 1, 2},
+		},
+		{
+			name: "bounds between the keys",
+			lo:   27,
+			hi:   100,
+			want: []int{27, 28, 29},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			b := newFrom(2, rand.New(rand.NewSource(1)).Perm(30)...)
+
+			var got []int
+			for k := range b.Range(tt.lo, tt.hi) {
+				got = append(got, k)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("break early", func(t *testing.T) {
+		t.Parallel()
+		b := newFrom(2, rand.New(rand.NewSource(1)).Perm(30)...)
+
+		var got []int
+		for k := range b.Range(3, 20) {
+			if k == 6 {
+				break
+			}
+			got = append(got, k)
+		}
+		assert.Equal(t, []int{3, 4, 5}, got)
+	})
+}
+
+func TestBTree_DeleteRange(t *testing.T) {
+	t.Parallel()
+
+	b := newFrom(3, rand.New(rand.NewSource(1)).Perm(100)...)
+
+	assert.Equal(t, 40, b.DeleteRange(10, 50))
+	assert.Equal(t, 0, b.DeleteRange(10, 50))
+	assert.Equal(t, 60, b.Len())
+	checkTree(t, b)
+
+	want := append(slices.Collect(intRange(0, 10)), slices.Collect(intRange(50, 100))...)
+	assert.Equal(t, want, keys(b))
+
+	assert.Equal(t, 0, b.DeleteRange(60, 60))
+	assert.Equal(t, 0, b.DeleteRange(70, 60))
+	assert.Equal(t, 60, b.DeleteRange(-1, 1_000))
+	assert.Nil(t, keys(b))
+	assert.Equal(t, 0, NewOrdered[int, string](2).DeleteRange(0, 10))
+}
+
+func intRange(lo, hi int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := lo; i < hi; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestFromSorted(t *testing.T) {
+	t.Parallel()
+
+	for _, degree := range []int{2, 3, 16} {
+		for _, n := range []int{0, 1, 3, 4, 7, 8, 50, 1_000} {
+			want := slices.Collect(intRange(0, n))
+
+			b, err := FromSorted(degree, cmp.Compare[int], seqOf(want))
+			assert.NoError(t, err)
+			checkTree(t, b)
+			assert.Equal(t, n, b.Len())
+
+			got := keys(b)
+			if n == 0 {
+				assert.Nil(t, got)
+				continue
+			}
+			assert.Equal(t, want, got)
+
+			b.Put(n, "")
+			b.Delete(0)
+			checkTree(t, b)
+		}
+	}
+
+	_, err := FromSorted(2, cmp.Compare[int], seqOf([]int{1, 3, 2}))
+	assert.ErrorIs(t, err, ErrNotSorted)
+	_, err = FromSorted(2, cmp.Compare[int], seqOf([]int{1, 1}))
+	assert.ErrorIs(t, err, ErrNotSorted)
+}
+
+func TestBTree_Clone(t *testing.T) {
+	t.Parallel()
+
+	b := newFrom(2, slices.Collect(intRange(0, 100))...)
+	c := b.Clone()
+
+	b.Put(100, "")
+	b.Delete(0)
+	b.DeleteRange(40, 60)
+
+	c.Put(-1, "")
+	c.Put(50, "fifty")
+
+	checkTree(t, b)
+	checkTree(t, c)
+
+	want := append(slices.Collect(intRange(1, 40)), slices.Collect(intRange(60, 101))...)
+	assert.Equal(t, want, keys(b))
+	assert.Equal(t, slices.Collect(intRange(-1, 100)), keys(c))
+
+	_, ok := b.Get(50)
+	assert.False(t, ok)
+	v, _ := c.Get(50)
+	assert.Equal(t, "fifty", v)
+
+	d := c.Clone()
+	d.DeleteRange(-1, 100)
+	assert.Zero(t, d.Len())
+	assert.Equal(t, 101, c.Len())
+}
+
+func TestBTree_Random(t *testing.T) {
+	t.Parallel()
+
+	for _, degree := range []int{2, 3, 8} {
+		r := rand.New(rand.NewSource(int64(degree)))
+		b := NewOrdered[int, string](degree)
+		ref := make(map[int]bool)
+
+		var snap *BTree[int, string]
+		var snapKeys []int
+
+		for i := 0; i < 5_000; i++ {
+			k := r.Intn(500)
+			if r.Intn(3) == 0 {
+				assert.Equal(t, ref[k], b.Delete(k))
+				delete(ref, k)
+			} else {
+				assert.Equal(t, !ref[k], b.Put(k, ""))
+				ref[k] = true
+			}
+
+			if i%500 == 0 {
+				checkTree(t, b)
+				if snap != nil {
+					assert.Equal(t, snapKeys, keys(snap))
+				}
+				snap, snapKeys = b.Clone(), keys(b)
+			}
+		}
+
+		checkTree(t, b)
+
+		want := slices.Sorted(maps.Keys(ref))
+		assert.Equal(t, want, keys(b))
+		assert.Equal(t, len(want), b.Len())
+	}
+}
+
+func BenchmarkInsert(b *testing.B) {
+	for _, n := range []int{1_000, 100_000} {
+		keys := rand.New(rand.NewSource(1)).Perm(n)
+
+		b.Run(fmt.Sprintf("btree/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				t := NewOrdered[int, int](32)
+				for _, k := range keys {
+					t.Put(k, k)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("treemap/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				m := treemap.NewOrdered[int, int]()
+				for _, k := range keys {
+					m.Put(k, k)
+				}
+			}
+		})
+
+		// Sorted inserts into a linked list are O(n) each, so only the
+		// small size is worth running.
+		if n > 1_000 {
+			continue
+		}
+		b.Run(fmt.Sprintf("sorted_sll/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				l := &sll.SLList[int]{}
+				for _, k := range keys {
+					idx := l.IndexFunc(func(v int) bool { return v > k })
+					if idx < 0 {
+						l.Insert(k)
+					} else {
+						_ = l.InsertAt(idx, k)
+					}
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	const n = 100_000
+	keys := rand.New(rand.NewSource(1)).Perm(n)
+
+	t := NewOrdered[int, int](32)
+	m := treemap.NewOrdered[int, int]()
+	for _, k := range keys {
+		t.Put(k, k)
+		m.Put(k, k)
+	}
+
+	b.Run("btree", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			t.Get(keys[i%n])
+		}
+	})
+
+	b.Run("treemap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			m.Get(keys[i%n])
+		}
+	})
+}
+
+func BenchmarkFromSorted(b *testing.B) {
+	const n = 100_000
+	keys := slices.Collect(intRange(0, n))
+	seq := func(yield func(int, int) bool) {
+		for _, k := range keys {
+			if !yield(k, k) {
+				return
+			}
+		}
+	}
+
+	b.Run("bulk", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = FromSorted(32, cmp.Compare[int], seq)
+		}
+	})
+
+	b.Run("put", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			t := NewOrdered[int, int](32)
+			for _, k := range keys {
+				t.Put(k, k)
+			}
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package cache
+
+// ARC is the Adaptive Replacement Cache policy by Megiddo and Modha.
+// Resident keys are split between t1 (seen once recently) and t2 (seen at
+// least twice). The ghost lists b1 and b2 remember keys recently evicted
+// from t1 and t2, and hits on them shift the target size p of t1 towards
+// whichever side would have kept the key.
+type ARC[K comparable] struct {
+	capacity int
+	p        int
+
+	t1 *keyList[K]
+	t2 *keyList[K]
+	b1 *keyList[K]
+	b2 *keyList[K]
+}
+
+func NewARC[K comparable](capacity int) *ARC[K] {
+	mustBePositive(capacity)
+	return &ARC[K]{
+		capacity: capacity,
+		t1:       newKeyList[K](),
+		t2:       newKeyList[K](),
+		b1:       newKeyList[K](),
+		b2:       newKeyList[K](),
+	}
+}
+
+func (p *ARC[K]) Add(key K) (K, bool) {
+	var (
+		victim  K
+		evicted bool
+	)
+
+	switch {
+	case p.b1.has(key):
+		p.p = min(p.p+max(p.b2.len()/p.b1.len(), 1), p.capacity)
+		victim, evicted = p.replace(false)
+		p.b1.remove(key)
+		p.t2.pushFront(key)
+		return victim, evicted
+
+	case p.b2.has(key):
+		p.p = max(p.p-max(p.b1.len()/p.b2.len(), 1), 0)
+		victim, evicted = p.replace(true)
+		p.b2.remove(key)
+		p.t2.pushFront(key)
+		return victim, evicted
+	}
+
+	if l1 := p.t1.len() + p.b1.len(); l1 >= p.capacity {
+		if p.t1.len() < p.capacity {
+			p.b1.popBack()
+			victim, evicted = p.replace(false)
+		} else {
+			victim, evicted = p.t1.popBack()
+		}
+	} else if total := l1 + p.t2.len() + p.b2.len(); total >= p.capacity {
+		if total >= 2*p.capacity {
+			p.b2.popBack()
+		}
+		victim, evicted = p.replace(false)
+	}
+
+	p.t1.pushFront(key)
+
+	return victim, evicted
+}
+
+func (p *ARC[K]) Touch(key K) {
+	if p.t1.remove(key) {
+		p.t2.pushFront(key)
+		return
+	}
+	p.t2.moveToFront(key)
+}
+
+func (p *ARC[K]) Remove(key K) {
+	if !p.t1.remove(key) {
+		p.t2.remove(key)
+	}
+}
+
+func (p *ARC[K]) Len() int {
+	return p.t1.len() + p.t2.len()
+}
+
+// replace makes room for one key if the cache is full, moving the evicted
+// key into the matching ghost list.
+func (p *ARC[K]) replace(inB2 bool) (K, bool) {
+	var kNil K
+	if p.Len() < p.capacity {
+		return kNil, false
+	}
+
+	t1 := p.t1.len()
+	if t1 > 0 && (t1 > p.p || (inB2 && t1 == p.p) || p.t2.len() == 0) {
+		victim, _ := p.t1.popBack()
+		p.b1.pushFront(victim)
+		return victim, true
+	}
+
+	victim, _ := p.t2.popBack()
+	p.b2.pushFront(victim)
+	return victim, true
+}
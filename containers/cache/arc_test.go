@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestARC_PromoteOnTouch(t *testing.T) {
+	t.Parallel()
+
+	p := NewARC[string](2)
+	p.Add("a")
+	p.Add("b")
+	p.Touch("a")
+
+	assert.True(t, p.t2.has("a"))
+	assert.True(t, p.t1.has("b"))
+
+	// The only recency-only key goes first.
+	victim, ok := p.Add("c")
+	assert.True(t, ok)
+	assert.Equal(t, "b", victim)
+	assert.True(t, p.b1.has("b"))
+}
+
+func TestARC_Adapt(t *testing.T) {
+	t.Parallel()
+
+	p := NewARC[string](2)
+	p.Add("a")
+	p.Add("b")
+	p.Touch("a")
+	p.Add("c")
+	assert.Equal(t, 0, p.p)
+
+	// "b" was evicted from t1 too early: a ghost hit in b1 grows the
+	// target for t1 and brings "b" back as a frequent key. t1 is now at
+	// its target, so room is made in t2.
+	victim, ok := p.Add("b")
+	assert.True(t, ok)
+	assert.Equal(t, "a", victim)
+	assert.Equal(t, 1, p.p)
+	assert.True(t, p.t2.has("b"))
+	assert.False(t, p.b1.has("b"))
+	assert.True(t, p.b2.has("a"))
+
+	// A ghost hit in b2 shrinks the target again and t1 pays for it.
+	victim, ok = p.Add("a")
+	assert.True(t, ok)
+	assert.Equal(t, "c", victim)
+	assert.Equal(t, 0, p.p)
+	assert.True(t, p.t2.has("a"))
+	assert.Equal(t, 2, p.Len())
+}
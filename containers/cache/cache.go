@@ -0,0 +1,205 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ivdaria/go-containers/containers/heap"
+)
+
+// Clock tells the cache what time it is. Tests inject a fake one to make
+// expiry deterministic.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock reads the wall clock.
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+type entry[V any] struct {
+	val       V
+	expiresAt time.Time
+}
+
+// Stats counts what happened to the lookups and entries of a Cache.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Expired   uint64
+}
+
+// Cache maps keys to values with an optional per-entry time to live and a
+// pluggable eviction Policy. Expired entries are dropped lazily when they
+// are looked up, by DeleteExpired, or periodically by a janitor started
+// with StartJanitor. Cache is safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	clock   Clock
+	policy  Policy[K]
+	entries map[K]*entry[V]
+	expiry  *heap.Indexed[K, time.Time]
+	stats   Stats
+}
+
+// New returns a cache that evicts according to policy. A nil clock means
+// SystemClock.
+func New[K comparable, V any](policy Policy[K], clock Clock) *Cache[K, V] {
+	if clock == nil {
+		clock = SystemClock{}
+	}
+
+	return &Cache[K, V]{
+		clock:   clock,
+		policy:  policy,
+		entries: make(map[K]*entry[V]),
+		expiry:  heap.NewIndexed[K](func(a, b time.Time) bool { return a.Before(b) }),
+	}
+}
+
+// Set stores val under key. A positive ttl makes the entry expire after
+// that long; zero or a negative ttl keeps it until it is evicted or
+// deleted.
+func (c *Cache[K, V]) Set(key K, val V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = c.clock.Now().Add(ttl)
+		c.expiry.Upsert(key, expiresAt)
+	} else {
+		c.expiry.Delete(key)
+	}
+
+	if e, ok := c.entries[key]; ok {
+		e.val, e.expiresAt = val, expiresAt
+		c.policy.Touch(key)
+		return
+	}
+
+	c.entries[key] = &entry[V]{val: val, expiresAt: expiresAt}
+	if victim, ok := c.policy.Add(key); ok {
+		c.drop(victim)
+		c.stats.Evictions++
+	}
+}
+
+// Get returns the value for key unless it is missing or expired.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if ok && c.expired(e) {
+		c.policy.Remove(key)
+		c.drop(key)
+		c.stats.Expired++
+		ok = false
+	}
+
+	if !ok {
+		c.stats.Misses++
+		var vNil V
+		return vNil, false
+	}
+
+	c.stats.Hits++
+	c.policy.Touch(key)
+
+	return e.val, true
+}
+
+// Delete removes key and reports whether it was present.
+func (c *Cache[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok {
+		return false
+	}
+
+	c.policy.Remove(key)
+	c.drop(key)
+
+	return true
+}
+
+// DeleteExpired drops every expired entry and returns how many there were.
+func (c *Cache[K, V]) DeleteExpired() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.clock.Now()
+	removed := 0
+
+	for {
+		key, expiresAt, ok := c.expiry.PeekMin()
+		if !ok || expiresAt.After(now) {
+			break
+		}
+
+		c.policy.Remove(key)
+		c.drop(key)
+		removed++
+	}
+
+	c.stats.Expired += uint64(removed)
+	return removed
+}
+
+// StartJanitor calls DeleteExpired every interval in a new goroutine until
+// the returned stop function is called.
+func (c *Cache[K, V]) StartJanitor(interval time.Duration) (stop func()) {
+	done := make(chan struct{})
+	var once sync.Once
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.DeleteExpired()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+// Len returns the number of stored entries, including expired ones that
+// have not been collected yet.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+func (c *Cache[K, V]) expired(e *entry[V]) bool {
+	return !e.expiresAt.IsZero() && !c.clock.Now().Before(e.expiresAt)
+}
+
+// drop forgets key in the cache itself; the caller keeps the policy in
+// sync.
+func (c *Cache[K, V]) drop(key K) {
+	delete(c.entries, key)
+	c.expiry.Delete(key)
+}
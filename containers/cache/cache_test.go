@@ -0,0 +1,172 @@
+package cache
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func TestCache_SetGet(t *testing.T) {
+	t.Parallel()
+	for _, p := range policies {
+		p := p
+		t.Run(p.name, func(t *testing.T) {
+			t.Parallel()
+			c := New[int, string](p.new(2), nil)
+
+			_, ok := c.Get(1)
+			assert.False(t, ok)
+
+			c.Set(1, "a", 0)
+			c.Set(2, "b", 0)
+			c.Set(1, "aa", 0)
+
+			v, ok := c.Get(1)
+			assert.True(t, ok)
+			assert.Equal(t, "aa", v)
+
+			c.Set(3, "c", 0)
+			assert.Equal(t, 2, c.Len())
+			assert.Equal(t, Stats{Hits: 1, Misses: 1, Evictions: 1}, c.Stats())
+
+			assert.True(t, c.Delete(3))
+			assert.False(t, c.Delete(3))
+		})
+	}
+}
+
+func TestCache_LazyExpiry(t *testing.T) {
+	type testCase struct {
+		name    string
+		ttl     time.Duration
+		advance time.Duration
+		wantOk  bool
+	}
+	tests := []testCase{
+		{
+			name:    "no ttl never expires",
+			ttl:     0,
+			advance: time.Hour,
+			wantOk:  true,
+		},
+		{
+			name:    "before deadline",
+			ttl:     time.Minute,
+			advance: time.Minute - time.Nanosecond,
+			wantOk:  true,
+		},
+		{
+			name:    "at deadline",
+			ttl:     time.Minute,
+			advance: time.Minute,
+			wantOk:  false,
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			clock := newFakeClock()
+			c := New[string, int](NewLRU[string](4), clock)
+
+			c.Set("a", 1, tt.ttl)
+			clock.Advance(tt.advance)
+
+			_, ok := c.Get("a")
+			assert.Equal(t, tt.wantOk, ok)
+			if !tt.wantOk {
+				assert.Equal(t, 0, c.Len())
+				assert.Equal(t, uint64(1), c.Stats().Expired)
+			}
+		})
+	}
+}
+
+func TestCache_SetResetsTTL(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	c := New[string, int](NewLFU[string](4), clock)
+
+	c.Set("a", 1, time.Minute)
+	clock.Advance(50 * time.Second)
+	c.Set("a", 2, 0)
+	clock.Advance(time.Hour)
+
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, 0, c.DeleteExpired())
+}
+
+func TestCache_DeleteExpired(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	c := New[string, int](NewARC[string](8), clock)
+
+	c.Set("a", 1, time.Second)
+	c.Set("b", 2, 3*time.Second)
+	c.Set("c", 3, 2*time.Second)
+	c.Set("d", 4, 0)
+
+	clock.Advance(2 * time.Second)
+	assert.Equal(t, 2, c.DeleteExpired())
+	assert.Equal(t, 2, c.Len())
+
+	_, ok := c.Get("b")
+	assert.True(t, ok)
+
+	clock.Advance(time.Hour)
+	assert.Equal(t, 1, c.DeleteExpired())
+	_, ok = c.Get("d")
+	assert.True(t, ok)
+
+	// Evicted entries do not linger in the expiry queue.
+	c.Set("e", 5, time.Second)
+	c.Delete("e")
+	clock.Advance(time.Hour)
+	assert.Equal(t, 0, c.DeleteExpired())
+}
+
+func TestCache_Janitor(t *testing.T) {
+	t.Parallel()
+
+	clock := newFakeClock()
+	c := New[string, int](NewTwoQ[string](4), clock)
+	c.Set("a", 1, time.Second)
+	clock.Advance(time.Minute)
+
+	stop := c.StartJanitor(time.Millisecond)
+	defer stop()
+
+	assert.Eventually(t, func() bool { return c.Len() == 0 }, time.Second, time.Millisecond)
+	stop()
+}
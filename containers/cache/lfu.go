@@ -0,0 +1,98 @@
+package cache
+
+import "github.com/ivdaria/go-containers/containers/dll"
+
+type lfuBucket[K comparable] struct {
+	freq int
+	keys dll.DLList[K]
+}
+
+type lfuEntry[K comparable] struct {
+	elem   *dll.Element[K]
+	bucket *dll.Element[*lfuBucket[K]]
+}
+
+// LFU evicts the least frequently used key, breaking ties by recency.
+// Keys are grouped into buckets of equal frequency kept in ascending
+// order, so every operation is O(1).
+type LFU[K comparable] struct {
+	capacity int
+	buckets  dll.DLList[*lfuBucket[K]]
+	entries  map[K]*lfuEntry[K]
+}
+
+func NewLFU[K comparable](capacity int) *LFU[K] {
+	mustBePositive(capacity)
+	return &LFU[K]{capacity: capacity, entries: make(map[K]*lfuEntry[K])}
+}
+
+func (p *LFU[K]) Add(key K) (K, bool) {
+	var (
+		victim  K
+		evicted bool
+	)
+	if len(p.entries) >= p.capacity {
+		// The front bucket has the lowest frequency and its back is the
+		// least recently used key within it.
+		victim, evicted = p.buckets.Front().Value.keys.Back().Value, true
+		p.Remove(victim)
+	}
+
+	first := p.buckets.Front()
+	if first == nil || first.Value.freq != 1 {
+		first = p.buckets.PushFront(&lfuBucket[K]{freq: 1})
+	}
+	p.entries[key] = &lfuEntry[K]{
+		elem:   first.Value.keys.PushFront(key),
+		bucket: first,
+	}
+
+	return victim, evicted
+}
+
+func (p *LFU[K]) Touch(key K) {
+	ent, ok := p.entries[key]
+	if !ok {
+		return
+	}
+
+	cur := ent.bucket
+	next := cur.Next()
+	if next == nil || next.Value.freq != cur.Value.freq+1 {
+		next = p.buckets.InsertAfter(&lfuBucket[K]{freq: cur.Value.freq + 1}, cur)
+	}
+
+	cur.Value.keys.Remove(ent.elem)
+	ent.elem = next.Value.keys.PushFront(key)
+	ent.bucket = next
+
+	if cur.Value.keys.IsEmpty() {
+		p.buckets.Remove(cur)
+	}
+}
+
+func (p *LFU[K]) Remove(key K) {
+	ent, ok := p.entries[key]
+	if !ok {
+		return
+	}
+
+	ent.bucket.Value.keys.Remove(ent.elem)
+	if ent.bucket.Value.keys.IsEmpty() {
+		p.buckets.Remove(ent.bucket)
+	}
+	delete(p.entries, key)
+}
+
+// Frequency returns how many times key was added or touched.
+func (p *LFU[K]) Frequency(key K) int {
+	ent, ok := p.entries[key]
+	if !ok {
+		return 0
+	}
+	return ent.bucket.Value.freq
+}
+
+func (p *LFU[K]) Len() int {
+	return len(p.entries)
+}
@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLFU(t *testing.T) {
+	type testCase struct {
+		name       string
+		touches    []string
+		wantVictim string
+	}
+	tests := []testCase{
+		{
+			name:       "least frequent wins",
+			touches:    []string{"a", "a", "b", "c", "c"},
+			wantVictim: "b",
+		},
+		{
+			name:       "ties broken by recency",
+			touches:    []string{"b", "c", "a"},
+			wantVictim: "b",
+		},
+		{
+			name:       "untouched oldest",
+			touches:    nil,
+			wantVictim: "a",
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p := NewLFU[string](3)
+			p.Add("a")
+			p.Add("b")
+			p.Add("c")
+			for _, k := range tt.touches {
+				p.Touch(k)
+			}
+
+			victim, ok := p.Add("d")
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantVictim, victim)
+			assert.Equal(t, 1, p.Frequency("d"))
+			assert.Equal(t, 3, p.Len())
+		})
+	}
+}
+
+func TestLFU_Buckets(t *testing.T) {
+	t.Parallel()
+
+	p := NewLFU[string](4)
+	p.Add("a")
+	p.Add("b")
+	p.Touch("a")
+	p.Touch("a")
+	p.Touch("b")
+
+	assert.Equal(t, 3, p.Frequency("a"))
+	assert.Equal(t, 2, p.Frequency("b"))
+	assert.Equal(t, 0, p.Frequency("z"))
+
+	// Emptied buckets are dropped, so only frequencies 2 and 3 remain.
+	var freqs []int
+	for b := range p.buckets.Values() {
+		freqs = append(freqs, b.freq)
+	}
+	assert.Equal(t, []int{2, 3}, freqs)
+
+	p.Remove("a")
+	p.Remove("b")
+	assert.Equal(t, 0, p.Len())
+	assert.True(t, p.buckets.IsEmpty())
+}
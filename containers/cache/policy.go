@@ -0,0 +1,115 @@
+package cache
+
+import "github.com/ivdaria/go-containers/containers/dll"
+
+// Policy decides which key a Cache drops when it runs out of room. A
+// policy owns the capacity: Add records a new resident key and, if that
+// overflows the capacity, returns another key the cache must evict.
+// Policies are not safe for concurrent use; the Cache serialises calls.
+type Policy[K comparable] interface {
+	// Add records key, which must not be resident yet.
+	Add(key K) (victim K, evicted bool)
+	// Touch records a hit on a resident key.
+	Touch(key K)
+	// Remove forgets a resident key that the cache dropped on its own,
+	// for example because it expired.
+	Remove(key K)
+	// Len returns the number of resident keys.
+	Len() int
+}
+
+// keyList is a doubly linked list of keys with an index for O(1) lookup,
+// the building block shared by every policy in this package. The front
+// holds the most recent key.
+type keyList[K comparable] struct {
+	list  dll.DLList[K]
+	index map[K]*dll.Element[K]
+}
+
+func newKeyList[K comparable]() *keyList[K] {
+	return &keyList[K]{index: make(map[K]*dll.Element[K])}
+}
+
+func (l *keyList[K]) has(key K) bool {
+	_, ok := l.index[key]
+	return ok
+}
+
+func (l *keyList[K]) pushFront(key K) {
+	l.index[key] = l.list.PushFront(key)
+}
+
+func (l *keyList[K]) moveToFront(key K) {
+	l.list.MoveToFront(l.index[key])
+}
+
+func (l *keyList[K]) remove(key K) bool {
+	e, ok := l.index[key]
+	if !ok {
+		return false
+	}
+
+	l.list.Remove(e)
+	delete(l.index, key)
+
+	return true
+}
+
+// popBack removes and returns the least recent key.
+func (l *keyList[K]) popBack() (K, bool) {
+	e := l.list.Back()
+	if e == nil {
+		var kNil K
+		return kNil, false
+	}
+
+	delete(l.index, e.Value)
+	return l.list.Remove(e), true
+}
+
+func (l *keyList[K]) len() int {
+	return l.list.Size()
+}
+
+// LRU evicts the least recently used key.
+type LRU[K comparable] struct {
+	capacity int
+	keys     *keyList[K]
+}
+
+func NewLRU[K comparable](capacity int) *LRU[K] {
+	mustBePositive(capacity)
+	return &LRU[K]{capacity: capacity, keys: newKeyList[K]()}
+}
+
+func (p *LRU[K]) Add(key K) (K, bool) {
+	var (
+		victim  K
+		evicted bool
+	)
+	if p.keys.len() >= p.capacity {
+		victim, evicted = p.keys.popBack()
+	}
+
+	p.keys.pushFront(key)
+
+	return victim, evicted
+}
+
+func (p *LRU[K]) Touch(key K) {
+	p.keys.moveToFront(key)
+}
+
+func (p *LRU[K]) Remove(key K) {
+	p.keys.remove(key)
+}
+
+func (p *LRU[K]) Len() int {
+	return p.keys.len()
+}
+
+func mustBePositive(capacity int) {
+	if capacity <= 0 {
+		panic("cache: capacity must be positive")
+	}
+}
@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var policies = []struct {
+	name string
+	new  func(capacity int) Policy[int]
+}{
+	{name: "lru", new: func(c int) Policy[int] { return NewLRU[int](c) }},
+	{name: "lfu", new: func(c int) Policy[int] { return NewLFU[int](c) }},
+	{name: "2q", new: func(c int) Policy[int] { return NewTwoQ[int](c) }},
+	{name: "arc", new: func(c int) Policy[int] { return NewARC[int](c) }},
+}
+
+func TestPolicy_Panics(t *testing.T) {
+	t.Parallel()
+	for _, p := range policies {
+		assert.Panics(t, func() { p.new(0) }, p.name)
+	}
+}
+
+// TestPolicy_Invariants drives every policy with random operations and
+// checks that it never exceeds its capacity and only evicts resident keys.
+func TestPolicy_Invariants(t *testing.T) {
+	t.Parallel()
+	for _, p := range policies {
+		p := p
+		t.Run(p.name, func(t *testing.T) {
+			t.Parallel()
+			const capacity = 8
+
+			r := rand.New(rand.NewSource(1))
+			policy := p.new(capacity)
+			resident := make(map[int]bool)
+
+			for i := 0; i < 5_000; i++ {
+				key := r.Intn(32)
+				switch {
+				case resident[key] && r.Intn(5) == 0:
+					policy.Remove(key)
+					delete(resident, key)
+				case resident[key]:
+					policy.Touch(key)
+				default:
+					victim, ok := policy.Add(key)
+					if ok {
+						assert.True(t, resident[victim], "victim %d is not resident", victim)
+						assert.NotEqual(t, key, victim)
+						delete(resident, victim)
+					}
+					resident[key] = true
+				}
+
+				assert.LessOrEqual(t, policy.Len(), capacity)
+				assert.Equal(t, len(resident), policy.Len())
+			}
+		})
+	}
+}
+
+func TestLRU(t *testing.T) {
+	t.Parallel()
+
+	p := NewLRU[string](2)
+	_, ok := p.Add("a")
+	assert.False(t, ok)
+	p.Add("b")
+	p.Touch("a")
+
+	victim, ok := p.Add("c")
+	assert.True(t, ok)
+	assert.Equal(t, "b", victim)
+
+	p.Remove("a")
+	_, ok = p.Add("d")
+	assert.False(t, ok)
+	assert.Equal(t, 2, p.Len())
+}
@@ -0,0 +1,84 @@
+package cache
+
+// TwoQ is the full 2Q policy by Johnson and Shasha. New keys enter the
+// FIFO a1in; keys pushed out of it are remembered in the ghost FIFO a1out.
+// A key that comes back while it is still in a1out has proven to be
+// reused and is promoted to the LRU list am. This keeps one-off scans
+// from flushing the frequently used keys.
+type TwoQ[K comparable] struct {
+	capacity int
+	kin      int
+	kout     int
+
+	a1in  *keyList[K]
+	a1out *keyList[K]
+	am    *keyList[K]
+}
+
+// NewTwoQ sizes a1in to a quarter and a1out to a half of capacity, as
+// recommended by the paper.
+func NewTwoQ[K comparable](capacity int) *TwoQ[K] {
+	mustBePositive(capacity)
+	return &TwoQ[K]{
+		capacity: capacity,
+		kin:      max(capacity/4, 1),
+		kout:     max(capacity/2, 1),
+		a1in:     newKeyList[K](),
+		a1out:    newKeyList[K](),
+		am:       newKeyList[K](),
+	}
+}
+
+func (p *TwoQ[K]) Add(key K) (K, bool) {
+	var (
+		victim  K
+		evicted bool
+	)
+
+	// Check a1out before reclaiming, which may push the key out of it.
+	promote := p.a1out.remove(key)
+	if p.Len() >= p.capacity {
+		victim, evicted = p.reclaim()
+	}
+
+	if promote {
+		p.am.pushFront(key)
+	} else {
+		p.a1in.pushFront(key)
+	}
+
+	return victim, evicted
+}
+
+// Touch promotes hits in am only; hits in a1in are assumed to be
+// correlated references and leave the key where it is.
+func (p *TwoQ[K]) Touch(key K) {
+	if p.am.has(key) {
+		p.am.moveToFront(key)
+	}
+}
+
+func (p *TwoQ[K]) Remove(key K) {
+	if !p.a1in.remove(key) {
+		p.am.remove(key)
+	}
+}
+
+func (p *TwoQ[K]) Len() int {
+	return p.a1in.len() + p.am.len()
+}
+
+func (p *TwoQ[K]) reclaim() (K, bool) {
+	if p.a1in.len() > p.kin || p.am.len() == 0 {
+		victim, ok := p.a1in.popBack()
+		if ok {
+			p.a1out.pushFront(victim)
+			if p.a1out.len() > p.kout {
+				p.a1out.popBack()
+			}
+		}
+		return victim, ok
+	}
+
+	return p.am.popBack()
+}
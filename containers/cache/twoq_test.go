@@ -0,0 +1,73 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTwoQ_ScanResistance(t *testing.T) {
+	t.Parallel()
+
+	p := NewTwoQ[int](8)
+
+	// Keys 1 and 2 are pushed out of a1in into a1out; bringing them
+	// back promotes them to am.
+	for k := 1; k <= 10; k++ {
+		p.Add(k)
+	}
+	assert.False(t, p.am.has(1))
+	assert.True(t, p.a1out.has(1))
+
+	p.Remove(9)
+	p.Add(1)
+	p.Remove(10)
+	p.Add(2)
+	assert.True(t, p.am.has(1))
+	assert.True(t, p.am.has(2))
+
+	// A long scan of one-off keys must not push them out.
+	for k := 100; k < 200; k++ {
+		victim, ok := p.Add(k)
+		if ok {
+			assert.NotEqual(t, 1, victim)
+			assert.NotEqual(t, 2, victim)
+		}
+	}
+	assert.True(t, p.am.has(1))
+	assert.True(t, p.am.has(2))
+	assert.LessOrEqual(t, p.a1out.len(), p.kout)
+}
+
+func TestTwoQ_Touch(t *testing.T) {
+	t.Parallel()
+
+	p := NewTwoQ[int](4)
+	p.Add(1)
+	p.Touch(1)
+	assert.True(t, p.a1in.has(1))
+	assert.False(t, p.am.has(1))
+}
+
+func TestTwoQ_PromoteOldestGhost(t *testing.T) {
+	t.Parallel()
+
+	// With kout = 2, key 2 is the oldest ghost when it comes back. The
+	// reclaim for its own insertion must not push it out of a1out first.
+	p := NewTwoQ[int](4)
+	for k := 1; k <= 7; k++ {
+		p.Add(k)
+	}
+	assert.True(t, p.a1out.has(2))
+
+	p.Add(2)
+	assert.True(t, p.am.has(2))
+
+	for k := 100; k < 104; k++ {
+		victim, ok := p.Add(k)
+		if ok {
+			assert.NotEqual(t, 2, victim)
+		}
+	}
+	assert.True(t, p.am.has(2))
+}
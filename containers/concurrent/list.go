@@ -0,0 +1,165 @@
+package concurrent
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/ivdaria/go-containers/containers"
+)
+
+// List guards any containers.List with a sync.RWMutex so it can be shared
+// between goroutines. Callback based methods and iterators walk a snapshot
+// taken under the read lock, so they may call back into the list.
+type List[T any] struct {
+	mu   sync.RWMutex
+	list containers.List[T]
+}
+
+func New[T any](l containers.List[T]) *List[T] {
+	return &List[T]{list: l}
+}
+
+func (l *List[T]) Insert(elem T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.list.Insert(elem)
+}
+
+// Deprecated: use Each or All.
+func (l *List[T]) Traverse(f func(v any)) {
+	for _, v := range l.SnapshotSlice() {
+		f(v)
+	}
+}
+
+func (l *List[T]) Each(f func(idx int, v T) bool) {
+	for idx, v := range l.SnapshotSlice() {
+		if !f(idx, v) {
+			return
+		}
+	}
+}
+
+func (l *List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		l.Each(yield)
+	}
+}
+
+func (l *List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range l.SnapshotSlice() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (l *List[T]) ToSlice() []T {
+	return l.SnapshotSlice()
+}
+
+// SnapshotSlice copies the list values while holding the read lock.
+func (l *List[T]) SnapshotSlice() []T {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.list.ToSlice()
+}
+
+func (l *List[T]) IsEmpty() bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.list.IsEmpty()
+}
+
+func (l *List[T]) Size() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.list.Size()
+}
+
+func (l *List[T]) At(idx int) (T, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.list.At(idx)
+}
+
+func (l *List[T]) DeleteAt(idx int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.list.DeleteAt(idx)
+}
+
+func (l *List[T]) InsertFront(t T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.list.InsertFront(t)
+}
+
+func (l *List[T]) InsertAt(idx int, t T) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.list.InsertAt(idx, t)
+}
+
+func (l *List[T]) RemoveFunc(pred func(v T) bool) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.list.RemoveFunc(pred)
+}
+
+func (l *List[T]) Retain(pred func(v T) bool) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.list.Retain(pred)
+}
+
+func (l *List[T]) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.list.Clear()
+}
+
+func (l *List[T]) Truncate(n int) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.list.Truncate(n)
+}
+
+// Update replaces the value at idx with f(value) as a single atomic step.
+// The list is left untouched if f panics. Unlike the callbacks of Each and
+// All, f runs under the write lock, so calling back into l from f
+// deadlocks.
+func (l *List[T]) Update(idx int, f func(v T) T) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	v, err := l.list.At(idx)
+	if err != nil {
+		return err
+	}
+	nv := f(v)
+
+	if err := l.list.DeleteAt(idx); err != nil {
+		return err
+	}
+
+	if idx == l.list.Size() {
+		l.list.Insert(nv)
+		return nil
+	}
+	return l.list.InsertAt(idx, nv)
+}
@@ -0,0 +1,165 @@
+package concurrent
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers"
+	"github.com/ivdaria/go-containers/containers/dll"
+	"github.com/ivdaria/go-containers/containers/sll"
+)
+
+var _ containers.List[int] = (*List[int])(nil)
+
+var backends = []struct {
+	name string
+	new  func() containers.List[int]
+}{
+	{
+		name: "sll",
+		new:  func() containers.List[int] { return &sll.SLList[int]{} },
+	},
+	{
+		name: "dll",
+		new:  func() containers.List[int] { return &dll.DLList[int]{} },
+	},
+}
+
+func TestList_Update(t *testing.T) {
+	type testCase struct {
+		name    string
+		in      []int
+		idx     int
+		want    []int
+		wantErr error
+	}
+	tests := []testCase{
+		{
+			name:    "empty list",
+			in:      nil,
+			idx:     0,
+			want:    []int{},
+			wantErr: sll.ErrIndexIsOutOfSize,
+		},
+		{
+			name: "update head",
+			in:   []int{1, 2, 3},
+			idx:  0,
+			want: []int{10, 2, 3},
+		},
+		{
+			name: "update tail",
+			in:   []int{1, 2, 3},
+			idx:  2,
+			want: []int{1, 2, 30},
+		},
+	}
+
+	t.Parallel()
+	for _, b := range backends {
+		for _, tt := range tests {
+			b, tt := b, tt
+			t.Run(b.name+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+				l := New(b.new())
+				for _, v := range tt.in {
+					l.Insert(v)
+				}
+
+				err := l.Update(tt.idx, func(v int) int { return v * 10 })
+				if tt.wantErr != nil {
+					assert.Error(t, err)
+				} else {
+					assert.NoError(t, err)
+				}
+				assert.Equal(t, tt.want, l.SnapshotSlice())
+			})
+		}
+	}
+}
+
+func TestList_UpdatePanics(t *testing.T) {
+	t.Parallel()
+	for _, b := range backends {
+		b := b
+		t.Run(b.name, func(t *testing.T) {
+			t.Parallel()
+			l := New(b.new())
+			for _, v := range []int{1, 2, 3} {
+				l.Insert(v)
+			}
+
+			assert.Panics(t, func() {
+				_ = l.Update(1, func(int) int { panic("boom") })
+			})
+			assert.Equal(t, []int{1, 2, 3}, l.SnapshotSlice())
+		})
+	}
+}
+
+func TestList_CallbacksMayWrite(t *testing.T) {
+	t.Parallel()
+
+	l := New[int](&dll.DLList[int]{})
+	l.Insert(1)
+	l.Insert(2)
+
+	for _, v := range l.All() {
+		l.Insert(v + 10)
+	}
+	l.Each(func(_ int, v int) bool {
+		l.InsertFront(v)
+		return false
+	})
+
+	assert.Equal(t, []int{1, 1, 2, 11, 12}, l.ToSlice())
+}
+
+func TestList_Stress(t *testing.T) {
+	const (
+		workers = 8
+		ops     = 500
+	)
+
+	t.Parallel()
+	for _, b := range backends {
+		b := b
+		t.Run(b.name, func(t *testing.T) {
+			t.Parallel()
+			l := New(b.new())
+			l.Insert(0)
+
+			var wg sync.WaitGroup
+			for w := 0; w < workers; w++ {
+				wg.Add(3)
+				go func() {
+					defer wg.Done()
+					for i := 0; i < ops; i++ {
+						l.Insert(1)
+					}
+				}()
+				go func() {
+					defer wg.Done()
+					for i := 0; i < ops; i++ {
+						_ = l.Update(0, func(v int) int { return v + 1 })
+					}
+				}()
+				go func() {
+					defer wg.Done()
+					for i := 0; i < ops; i++ {
+						_ = l.SnapshotSlice()
+						_, _ = l.At(l.Size() - 1)
+					}
+				}()
+			}
+			wg.Wait()
+
+			head, err := l.At(0)
+			assert.NoError(t, err)
+			assert.Equal(t, workers*ops, head)
+			assert.Equal(t, workers*ops+1, l.Size())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package deque
+
+import "github.com/ivdaria/go-containers/containers/dll"
+
+// Deque is a double-ended queue backed by a doubly linked list.
+// The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	list dll.DLList[T]
+}
+
+func (d *Deque[T]) PushFront(v T) {
+	d.list.PushFront(v)
+}
+
+func (d *Deque[T]) PushBack(v T) {
+	d.list.PushBack(v)
+}
+
+func (d *Deque[T]) PopFront() (T, bool) {
+	e := d.list.Front()
+	if e == nil {
+		var tNil T
+		return tNil, false
+	}
+	return d.list.Remove(e), true
+}
+
+func (d *Deque[T]) PopBack() (T, bool) {
+	e := d.list.Back()
+	if e == nil {
+		var tNil T
+		return tNil, false
+	}
+	return d.list.Remove(e), true
+}
+
+func (d *Deque[T]) PeekFront() (T, bool) {
+	e := d.list.Front()
+	if e == nil {
+		var tNil T
+		return tNil, false
+	}
+	return e.Value, true
+}
+
+func (d *Deque[T]) PeekBack() (T, bool) {
+	e := d.list.Back()
+	if e == nil {
+		var tNil T
+		return tNil, false
+	}
+	return e.Value, true
+}
+
+func (d *Deque[T]) Len() int {
+	return d.list.Size()
+}
@@ -0,0 +1,187 @@
+package deque
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers"
+)
+
+var (
+	_ containers.Deque[int] = (*Deque[int])(nil)
+	_ containers.Deque[int] = (*Ring[int])(nil)
+)
+
+var implementations = []struct {
+	name string
+	new  func() containers.Deque[int]
+}{
+	{
+		name: "linked",
+		new:  func() containers.Deque[int] { return &Deque[int]{} },
+	},
+	{
+		name: "ring",
+		new:  func() containers.Deque[int] { return &Ring[int]{} },
+	},
+}
+
+func TestDeque_Empty(t *testing.T) {
+	t.Parallel()
+	for _, impl := range implementations {
+		impl := impl
+		t.Run(impl.name, func(t *testing.T) {
+			t.Parallel()
+			d := impl.new()
+			assert.Equal(t, 0, d.Len())
+
+			for _, op := range []func() (int, bool){d.PopFront, d.PopBack, d.PeekFront, d.PeekBack} {
+				got, ok := op()
+				assert.False(t, ok)
+				assert.Equal(t, 0, got)
+			}
+		})
+	}
+}
+
+func TestDeque_PushPop(t *testing.T) {
+	type testCase struct {
+		name      string
+		do        func(d containers.Deque[int]) []int
+		want      []int
+		wantFront int
+		wantBack  int
+		wantLen   int
+	}
+	tests := []testCase{
+		{
+			name: "fifo through back and front",
+			do: func(d containers.Deque[int]) []int {
+				d.PushBack(1)
+				d.PushBack(2)
+				d.PushBack(3)
+				a, _ := d.PopFront()
+				b, _ := d.PopFront()
+				return []int{a, b}
+			},
+			want:      []int{1, 2},
+			wantFront: 3,
+			wantBack:  3,
+			wantLen:   1,
+		},
+		{
+			name: "lifo through front",
+			do: func(d containers.Deque[int]) []int {
+				d.PushFront(1)
+				d.PushFront(2)
+				d.PushFront(3)
+				a, _ := d.PopFront()
+				return []int{a}
+			},
+			want:      []int{3},
+			wantFront: 2,
+			wantBack:  1,
+			wantLen:   2,
+		},
+		{
+			name: "mixed ends",
+			do: func(d containers.Deque[int]) []int {
+				d.PushBack(2)
+				d.PushFront(1)
+				d.PushBack(3)
+				a, _ := d.PopBack()
+				return []int{a}
+			},
+			want:      []int{3},
+			wantFront: 1,
+			wantBack:  2,
+			wantLen:   2,
+		},
+	}
+
+	t.Parallel()
+	for _, impl := range implementations {
+		for _, tt := range tests {
+			impl, tt := impl, tt
+			t.Run(impl.name+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+				d := impl.new()
+				assert.Equal(t, tt.want, tt.do(d))
+
+				front, ok := d.PeekFront()
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantFront, front)
+				back, ok := d.PeekBack()
+				assert.True(t, ok)
+				assert.Equal(t, tt.wantBack, back)
+				assert.Equal(t, tt.wantLen, d.Len())
+			})
+		}
+	}
+}
+
+func TestDeque_Many(t *testing.T) {
+	t.Parallel()
+	for _, impl := range implementations {
+		impl := impl
+		t.Run(impl.name, func(t *testing.T) {
+			t.Parallel()
+			d := impl.new()
+			for i := 0; i < 100; i++ {
+				if i%2 == 0 {
+					d.PushBack(i)
+				} else {
+					d.PushFront(i)
+				}
+			}
+			assert.Equal(t, 100, d.Len())
+
+			front, _ := d.PeekFront()
+			back, _ := d.PeekBack()
+			assert.Equal(t, 99, front)
+			assert.Equal(t, 98, back)
+
+			for i := 99; i >= 1; i -= 2 {
+				got, ok := d.PopFront()
+				assert.True(t, ok)
+				assert.Equal(t, i, got)
+			}
+			for i := 98; i >= 0; i -= 2 {
+				got, ok := d.PopBack()
+				assert.True(t, ok)
+				assert.Equal(t, i, got)
+			}
+			assert.Equal(t, 0, d.Len())
+		})
+	}
+}
+
+func BenchmarkDeque_PushPop(b *testing.B) {
+	for _, impl := range implementations {
+		b.Run(impl.name, func(b *testing.B) {
+			d := impl.new()
+			for i := 0; i < 1_000; i++ {
+				d.PushBack(i)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				d.PushBack(i)
+				_, _ = d.PopFront()
+			}
+		})
+	}
+}
+
+func BenchmarkDeque_Fill(b *testing.B) {
+	for _, impl := range implementations {
+		b.Run(impl.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				d := impl.new()
+				for j := 0; j < 10_000; j++ {
+					d.PushBack(j)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package deque
+
+const minRingCapacity = 8
+
+// Ring is a double-ended queue backed by a growable circular buffer.
+// The zero value is an empty deque ready to use.
+type Ring[T any] struct {
+	buf  []T
+	head int
+	size int
+}
+
+// NewRing returns a Ring with room for capacity values before it has to grow.
+func NewRing[T any](capacity int) *Ring[T] {
+	return &Ring[T]{buf: make([]T, max(capacity, minRingCapacity))}
+}
+
+func (r *Ring[T]) PushFront(v T) {
+	r.grow()
+	r.head = (r.head - 1 + len(r.buf)) % len(r.buf)
+	r.buf[r.head] = v
+	r.size++
+}
+
+func (r *Ring[T]) PushBack(v T) {
+	r.grow()
+	r.buf[(r.head+r.size)%len(r.buf)] = v
+	r.size++
+}
+
+func (r *Ring[T]) PopFront() (T, bool) {
+	var tNil T
+	if r.size == 0 {
+		return tNil, false
+	}
+
+	v := r.buf[r.head]
+	r.buf[r.head] = tNil
+	r.head = (r.head + 1) % len(r.buf)
+	r.size--
+
+	return v, true
+}
+
+func (r *Ring[T]) PopBack() (T, bool) {
+	var tNil T
+	if r.size == 0 {
+		return tNil, false
+	}
+
+	idx := (r.head + r.size - 1) % len(r.buf)
+	v := r.buf[idx]
+	r.buf[idx] = tNil
+	r.size--
+
+	return v, true
+}
+
+func (r *Ring[T]) PeekFront() (T, bool) {
+	if r.size == 0 {
+		var tNil T
+		return tNil, false
+	}
+	return r.buf[r.head], true
+}
+
+func (r *Ring[T]) PeekBack() (T, bool) {
+	if r.size == 0 {
+		var tNil T
+		return tNil, false
+	}
+	return r.buf[(r.head+r.size-1)%len(r.buf)], true
+}
+
+func (r *Ring[T]) Len() int {
+	return r.size
+}
+
+// grow doubles the buffer when it is full, unrolling the values so that
+// the front ends up at index 0.
+func (r *Ring[T]) grow() {
+	if r.size < len(r.buf) {
+		return
+	}
+
+	buf := make([]T, max(2*len(r.buf), minRingCapacity))
+	n := copy(buf, r.buf[r.head:])
+	copy(buf[n:], r.buf[:r.head])
+
+	r.buf = buf
+	r.head = 0
+}
@@ -0,0 +1,49 @@
+package deque
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRing_GrowWrapped(t *testing.T) {
+	t.Parallel()
+
+	r := NewRing[int](0)
+	assert.Equal(t, minRingCapacity, len(r.buf))
+
+	// Push to both ends so that the values wrap around the buffer end
+	// before it has to grow.
+	for i := 0; i < minRingCapacity/2; i++ {
+		r.PushBack(i)
+		r.PushFront(-i - 1)
+	}
+	assert.Equal(t, minRingCapacity, len(r.buf))
+	assert.NotEqual(t, 0, r.head)
+
+	r.PushBack(100)
+	assert.Equal(t, 2*minRingCapacity, len(r.buf))
+	assert.Equal(t, 0, r.head)
+
+	var got []int
+	for r.Len() > 0 {
+		v, _ := r.PopFront()
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{-4, -3, -2, -1, 0, 1, 2, 3, 100}, got)
+}
+
+func TestRing_PopClearsSlot(t *testing.T) {
+	t.Parallel()
+
+	r := NewRing[*int](1)
+	v := 1
+	r.PushBack(&v)
+	r.PushBack(&v)
+	_, _ = r.PopFront()
+	_, _ = r.PopBack()
+
+	for _, slot := range r.buf {
+		assert.Nil(t, slot)
+	}
+}
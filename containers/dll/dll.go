@@ -2,24 +2,45 @@ package dll
 
 import (
 	"errors"
-	"fmt"
+	"iter"
+	"slices"
 )
 
-type node[T any] struct {
-	prev *node[T]
-	next *node[T]
-	val  T
-}
-
 type DLList[T any] struct {
-	head *node[T]
-	tail *node[T]
+	head *Element[T]
+	tail *Element[T]
 	size int
+	id   *listID
 }
 
 var ErrIndexIsOutOfSize = errors.New("index is out of size")
 
-func (l *DLList[T]) getNodeByIdx(idx int) *node[T] {
+// FromSlice builds a list holding the values of s in order.
+func FromSlice[T any](s []T) *DLList[T] {
+	return From(slices.Values(s))
+}
+
+// From builds a list from the values produced by seq.
+func From[T any](seq iter.Seq[T]) *DLList[T] {
+	l := &DLList[T]{}
+	for v := range seq {
+		l.Insert(v)
+	}
+	return l
+}
+
+// getNodeByIdx walks from whichever end of the list is closer to idx.
+func (l *DLList[T]) getNodeByIdx(idx int) *Element[T] {
+	if idx >= l.size/2 {
+		current := l.tail
+
+		for count := l.size - 1; count > idx; count-- {
+			current = current.prev
+		}
+
+		return current
+	}
+
 	current := l.head
 
 	for count := 0; count < idx; count++ {
@@ -30,19 +51,7 @@ func (l *DLList[T]) getNodeByIdx(idx int) *node[T] {
 }
 
 func (l *DLList[T]) Insert(elem T) {
-	node := &node[T]{val: elem}
-
-	l.size++
-
-	if l.head == nil {
-		l.head = node
-		l.tail = node
-		return
-	}
-
-	l.tail.next = node
-	node.prev = l.tail
-	l.tail = node
+	l.PushBack(elem)
 }
 
 func (l *DLList[T]) GetTail() (T, error) {
@@ -50,7 +59,7 @@ func (l *DLList[T]) GetTail() (T, error) {
 		var tNil T
 		return tNil, ErrIndexIsOutOfSize
 	}
-	return l.tail.val, nil
+	return l.tail.Value, nil
 }
 
 func (l *DLList[T]) Reverse() {
@@ -59,7 +68,7 @@ func (l *DLList[T]) Reverse() {
 	}
 
 	current := l.head
-	var temp *node[T]
+	var temp *Element[T]
 
 	l.head, l.tail = l.tail, l.head
 
@@ -70,13 +79,72 @@ func (l *DLList[T]) Reverse() {
 	}
 }
 
+// Traverse calls f for every value from head to tail.
+//
+// Deprecated: use Each or All, which are typed and can stop early.
 func (l *DLList[T]) Traverse(f func(v any)) {
-	current := l.head
+	l.Each(func(_ int, v T) bool {
+		f(v)
+		return true
+	})
+}
 
-	for current != nil {
-		f(current.val)
-		current = current.next
+// Each calls f for every element from head to tail until f returns false.
+func (l *DLList[T]) Each(f func(idx int, v T) bool) {
+	idx := 0
+	for current := l.head; current != nil; current = current.next {
+		if !f(idx, current.Value) {
+			return
+		}
+		idx++
+	}
+}
+
+// All returns an iterator over index-value pairs from head to tail.
+func (l *DLList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		idx := 0
+		for current := l.head; current != nil; current = current.next {
+			if !yield(idx, current.Value) {
+				return
+			}
+			idx++
+		}
+	}
+}
+
+// Values returns an iterator over the list values from head to tail.
+func (l *DLList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.head; current != nil; current = current.next {
+			if !yield(current.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over index-value pairs from tail to head,
+// following the prev links. Indexes are reported in descending order.
+func (l *DLList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		idx := l.size - 1
+		for current := l.tail; current != nil; current = current.prev {
+			if !yield(idx, current.Value) {
+				return
+			}
+			idx--
+		}
+	}
+}
+
+// ToSlice copies the list values into a new slice.
+func (l *DLList[T]) ToSlice() []T {
+	s := make([]T, 0, l.size)
+	for current := l.head; current != nil; current = current.next {
+		s = append(s, current.Value)
 	}
+	return s
 }
 
 func (l *DLList[T]) IsEmpty() bool {
@@ -98,7 +166,7 @@ func (l *DLList[T]) At(idx int) (T, error) {
 
 	current := l.getNodeByIdx(idx)
 
-	return current.val, nil
+	return current.Value, nil
 }
 
 func (l *DLList[T]) DeleteAt(idx int) error {
@@ -106,30 +174,7 @@ func (l *DLList[T]) DeleteAt(idx int) error {
 		return ErrIndexIsOutOfSize
 	}
 
-	if idx == 0 && l.size == 1 {
-		l.head, l.tail, l.size = nil, nil, 0
-		return nil
-	}
-
-	l.size--
-
-	if idx == 0 {
-		l.head.val = l.head.next.val
-		l.head.next = l.head.next.next
-		l.head.prev = nil
-		return nil
-	}
-
-	if idx == l.size-1 {
-		if err := l.DeleteFromTail(); err != nil {
-			return fmt.Errorf("delete from tail: %w", err)
-		}
-		return nil
-	}
-
-	current := l.getNodeByIdx(idx - 1)
-
-	current.next, current.next.next.prev = current.next.next, current
+	l.unlink(l.getNodeByIdx(idx))
 
 	return nil
 }
@@ -139,56 +184,29 @@ func (l *DLList[T]) DeleteFromTail() error {
 		return ErrIndexIsOutOfSize
 	}
 
-	if l.size == 1 {
-		l.head, l.tail, l.size = nil, nil, 0
-		return nil
-	}
-
-	if l.size == 2 {
-		l.tail = l.head
-		l.head.next = nil
-		l.size = 1
-		return nil
-	}
-
-	l.size--
-
-	current := l.head
-	for current.next.next != nil {
-		current = current.next
-	}
-	l.tail = current
+	l.unlink(l.tail)
 
 	return nil
 }
 
+// InsertAt inserts t before the element at idx. An idx at or past the end
+// appends t; only a negative idx is an error.
 func (l *DLList[T]) InsertAt(idx int, t T) error {
-	if idx < 0 || idx >= l.size {
+	if idx < 0 {
 		return ErrIndexIsOutOfSize
 	}
 
-	if idx == 0 {
-		l.InsertFront(t)
+	if idx >= l.size {
+		l.Insert(t)
 		return nil
 	}
 
-	node := &node[T]{val: t}
-
-	current := l.getNodeByIdx(idx - 1)
+	current := l.getNodeByIdx(idx)
+	l.link(&Element[T]{Value: t}, current.prev, current)
 
-	node.next, node.prev = current.next, current
-	current.next, current.next.prev = node, node
-	l.size++
 	return nil
 }
 
 func (l *DLList[T]) InsertFront(t T) {
-	if l.head == nil {
-		l.Insert(t)
-		return
-	}
-	node := &node[T]{val: t}
-	node.next, l.head.prev = l.head, node
-	l.head = node
-	l.size++
+	l.PushFront(t)
 }
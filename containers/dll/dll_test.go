@@ -1,11 +1,24 @@
 package dll
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers"
 )
 
+var _ containers.List[int] = (*DLList[int])(nil)
+
+// linkPrev restores the prev links of a list built from a literal.
+func linkPrev[T any](l DLList[T]) DLList[T] {
+	for current := l.head; current != nil && current.next != nil; current = current.next {
+		current.next.prev = current
+	}
+	return l
+}
+
 func TestDLList_Insert(t *testing.T) {
 	type args[T any] struct {
 		elem T
@@ -34,14 +47,14 @@ func TestDLList_Insert(t *testing.T) {
 			name: "insert to list with one element",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			args: args[int]{
 				elem: 12,
@@ -53,17 +66,17 @@ func TestDLList_Insert(t *testing.T) {
 			name: "insert to list with two elements",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: nil,
-							val:  121,
+					head: &Element[int]{
+						next: &Element[int]{
+							next:  nil,
+							Value: 121,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 2,
 				}
 				l.tail = l.head.next
-				return l
+				return linkPrev(l)
 			},
 
 			args: args[int]{
@@ -109,14 +122,14 @@ func TestDLList_GetTail(t *testing.T) {
 			name: "tail equals head",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			want:    11,
 			wantErr: nil,
@@ -125,17 +138,17 @@ func TestDLList_GetTail(t *testing.T) {
 			name: "just a regular tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: nil,
-							val:  121,
+					head: &Element[int]{
+						next: &Element[int]{
+							next:  nil,
+							Value: 121,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 2,
 				}
 				l.tail = l.head.next
-				return l
+				return linkPrev(l)
 			},
 			want:    121,
 			wantErr: nil,
@@ -173,14 +186,14 @@ func TestDLList_IsEmpty(t *testing.T) {
 			name: "check list where head equals tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			want: false,
 		},
@@ -188,17 +201,17 @@ func TestDLList_IsEmpty(t *testing.T) {
 			name: "check list with head and tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: nil,
-							val:  121,
+					head: &Element[int]{
+						next: &Element[int]{
+							next:  nil,
+							Value: 121,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 2,
 				}
 				l.tail = l.head.next
-				return l
+				return linkPrev(l)
 			},
 			want: false,
 		},
@@ -206,20 +219,20 @@ func TestDLList_IsEmpty(t *testing.T) {
 			name: "check list with head, body and tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: &node[int]{
-								next: nil,
-								val:  13,
+					head: &Element[int]{
+						next: &Element[int]{
+							next: &Element[int]{
+								next:  nil,
+								Value: 13,
 							},
-							val: 12,
+							Value: 12,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 3,
 				}
 				l.tail = l.head.next.next
-				return l
+				return linkPrev(l)
 			},
 			want: false,
 		},
@@ -254,14 +267,14 @@ func TestDLList_Size(t *testing.T) {
 			name: "check list where head equals tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			want: 1,
 		},
@@ -269,17 +282,17 @@ func TestDLList_Size(t *testing.T) {
 			name: "check list with head and tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: nil,
-							val:  121,
+					head: &Element[int]{
+						next: &Element[int]{
+							next:  nil,
+							Value: 121,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 2,
 				}
 				l.tail = l.head.next
-				return l
+				return linkPrev(l)
 			},
 			want: 2,
 		},
@@ -287,20 +300,20 @@ func TestDLList_Size(t *testing.T) {
 			name: "check list with head, body and tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: &node[int]{
-								next: nil,
-								val:  13,
+					head: &Element[int]{
+						next: &Element[int]{
+							next: &Element[int]{
+								next:  nil,
+								Value: 13,
 							},
-							val: 12,
+							Value: 12,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 3,
 				}
 				l.tail = l.head.next.next
-				return l
+				return linkPrev(l)
 			},
 			want: 3,
 		},
@@ -344,14 +357,14 @@ func TestDLList_At(t *testing.T) {
 			name: "check negative index",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			args: args{
 				idx: -1,
@@ -363,14 +376,14 @@ func TestDLList_At(t *testing.T) {
 			name: "check index is equal to size",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			args: args{
 				idx: 1,
@@ -382,14 +395,14 @@ func TestDLList_At(t *testing.T) {
 			name: "check index is bigger than size",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			args: args{
 				idx: 2,
@@ -401,14 +414,14 @@ func TestDLList_At(t *testing.T) {
 			name: "check list with head equal to tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			args: args{
 				idx: 0,
@@ -420,17 +433,17 @@ func TestDLList_At(t *testing.T) {
 			name: "check list with head and tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: nil,
-							val:  121,
+					head: &Element[int]{
+						next: &Element[int]{
+							next:  nil,
+							Value: 121,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 2,
 				}
 				l.tail = l.head.next
-				return l
+				return linkPrev(l)
 			},
 			args: args{
 				idx: 1,
@@ -442,20 +455,20 @@ func TestDLList_At(t *testing.T) {
 			name: "check list with head, body and tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: &node[int]{
-								next: nil,
-								val:  13,
+					head: &Element[int]{
+						next: &Element[int]{
+							next: &Element[int]{
+								next:  nil,
+								Value: 13,
 							},
-							val: 12,
+							Value: 12,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 3,
 				}
 				l.tail = l.head.next.next
-				return l
+				return linkPrev(l)
 			},
 			args: args{
 				idx: 1,
@@ -505,14 +518,14 @@ func TestDLList_DeleteAt(t *testing.T) {
 			name: "check negative index",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			args: args{
 				idx: -1,
@@ -524,14 +537,14 @@ func TestDLList_DeleteAt(t *testing.T) {
 			name: "check index is equal to size",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			args: args{
 				idx: 1,
@@ -543,14 +556,14 @@ func TestDLList_DeleteAt(t *testing.T) {
 			name: "check index is bigger than size",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			args: args{
 				idx: 2,
@@ -562,14 +575,14 @@ func TestDLList_DeleteAt(t *testing.T) {
 			name: "check list with head equal to tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			args: args{
 				idx: 0,
@@ -581,17 +594,17 @@ func TestDLList_DeleteAt(t *testing.T) {
 			name: "check list with head and tail, del head",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: nil,
-							val:  12,
+					head: &Element[int]{
+						next: &Element[int]{
+							next:  nil,
+							Value: 12,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 2,
 				}
 				l.tail = l.head.next
-				return l
+				return linkPrev(l)
 			},
 			args: args{
 				idx: 0,
@@ -603,24 +616,25 @@ func TestDLList_DeleteAt(t *testing.T) {
 			name: "check list with head, body and tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: &node[int]{
-								next: nil,
-								val:  13,
+					head: &Element[int]{
+						next: &Element[int]{
+							next: &Element[int]{
+								next:  nil,
+								Value: 13,
 							},
-							val: 12,
+							Value: 12,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 3,
 				}
 				l.tail = l.head.next.next
-				return l
+				return linkPrev(l)
 			},
 			args: args{
 				idx: 1,
 			},
+			want:    13,
 			wantErr: nil,
 		},
 	}
@@ -664,14 +678,14 @@ func TestDLList_PushFront(t *testing.T) {
 			name: "push in list where head equal to tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			args: args[int]{
 				t: 18,
@@ -681,17 +695,17 @@ func TestDLList_PushFront(t *testing.T) {
 			name: "push in list with head and tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: nil,
-							val:  12,
+					head: &Element[int]{
+						next: &Element[int]{
+							next:  nil,
+							Value: 12,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 2,
 				}
 				l.tail = l.head.next
-				return l
+				return linkPrev(l)
 			},
 			args: args[int]{
 				t: 18,
@@ -730,14 +744,14 @@ func TestDLList_InsertAt(t *testing.T) {
 			name: "negative index",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			args: args[int]{
 				idx: -1,
@@ -751,14 +765,14 @@ func TestDLList_InsertAt(t *testing.T) {
 			name: "index is bigger than size",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			args: args[int]{
 				idx: 2,
@@ -772,14 +786,14 @@ func TestDLList_InsertAt(t *testing.T) {
 			name: "index is zero",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			args: args[int]{
 				idx: 0,
@@ -806,20 +820,20 @@ func TestDLList_InsertAt(t *testing.T) {
 			name: "regular insertAt",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: &node[int]{
-								next: nil,
-								val:  13,
+					head: &Element[int]{
+						next: &Element[int]{
+							next: &Element[int]{
+								next:  nil,
+								Value: 13,
 							},
-							val: 12,
+							Value: 12,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 3,
 				}
 				l.tail = l.head.next.next
-				return l
+				return linkPrev(l)
 			},
 			args: args[int]{
 				idx: 1,
@@ -864,60 +878,60 @@ func TestDLList_Reverse(t *testing.T) {
 			name: "head and tail",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: nil,
-							val:  12,
+					head: &Element[int]{
+						next: &Element[int]{
+							next:  nil,
+							Value: 12,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 2,
 				}
 				l.tail = l.head.next
-				return l
+				return linkPrev(l)
 			},
 		},
 		{
 			name: "head, tail and body",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: &node[int]{
-								next: nil,
-								val:  13,
+					head: &Element[int]{
+						next: &Element[int]{
+							next: &Element[int]{
+								next:  nil,
+								Value: 13,
 							},
-							val: 12,
+							Value: 12,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 3,
 				}
 				l.tail = l.head.next.next
-				return l
+				return linkPrev(l)
 			},
 		},
 		{
 			name: "head, tail and body 2.0",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: &node[int]{
-								next: &node[int]{
-									next: nil,
-									val:  14,
+					head: &Element[int]{
+						next: &Element[int]{
+							next: &Element[int]{
+								next: &Element[int]{
+									next:  nil,
+									Value: 14,
 								},
-								val: 13,
+								Value: 13,
 							},
-							val: 12,
+							Value: 12,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 4,
 				}
 				l.tail = l.head.next.next.next
-				return l
+				return linkPrev(l)
 			},
 		},
 	}
@@ -927,9 +941,9 @@ func TestDLList_Reverse(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			l := tt.l()
-			wasHead, wasTail := l.head.val, l.tail.val
+			wasHead, wasTail := l.head.Value, l.tail.Value
 			l.Reverse()
-			nowHead, nowTail := l.head.val, l.tail.val
+			nowHead, nowTail := l.head.Value, l.tail.Value
 			assert.Equal(t, wasHead, nowTail)
 			assert.Equal(t, wasTail, nowHead)
 		})
@@ -952,23 +966,23 @@ func TestDLList_Traverse(t *testing.T) {
 			name: "traverse forward",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: &node[int]{
-								next: &node[int]{
-									next: nil,
-									val:  14,
+					head: &Element[int]{
+						next: &Element[int]{
+							next: &Element[int]{
+								next: &Element[int]{
+									next:  nil,
+									Value: 14,
 								},
-								val: 13,
+								Value: 13,
 							},
-							val: 12,
+							Value: 12,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 4,
 				}
 				l.tail = l.head.next.next.next
-				return l
+				return linkPrev(l)
 			},
 			args: args{
 				f: func(m []int) func(v any) {
@@ -1018,14 +1032,14 @@ func TestDLList_Delete(t *testing.T) {
 			name: "delete in ddl with one node",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: nil,
-						val:  11,
+					head: &Element[int]{
+						next:  nil,
+						Value: 11,
 					},
 					size: 1,
 				}
 				l.tail = l.head
-				return l
+				return linkPrev(l)
 			},
 			wantErr:  nil,
 			wantTail: 0,
@@ -1034,17 +1048,17 @@ func TestDLList_Delete(t *testing.T) {
 			name: "delete in ddl with two nodes",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: nil,
-							val:  12,
+					head: &Element[int]{
+						next: &Element[int]{
+							next:  nil,
+							Value: 12,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 2,
 				}
 				l.tail = l.head.next
-				return l
+				return linkPrev(l)
 			},
 			wantErr:  nil,
 			wantTail: 11,
@@ -1053,20 +1067,20 @@ func TestDLList_Delete(t *testing.T) {
 			name: "delete in ddl with three nodes",
 			l: func() DLList[int] {
 				l := DLList[int]{
-					head: &node[int]{
-						next: &node[int]{
-							next: &node[int]{
-								next: nil,
-								val:  13,
+					head: &Element[int]{
+						next: &Element[int]{
+							next: &Element[int]{
+								next:  nil,
+								Value: 13,
 							},
-							val: 12,
+							Value: 12,
 						},
-						val: 11,
+						Value: 11,
 					},
 					size: 3,
 				}
 				l.tail = l.head.next.next
-				return l
+				return linkPrev(l)
 			},
 			wantErr:  nil,
 			wantTail: 12,
@@ -1084,8 +1098,311 @@ func TestDLList_Delete(t *testing.T) {
 			if err == nil && l.size > 0 {
 				nowTail, _ := l.GetTail()
 				assert.Equal(t, nowTail, tt.wantTail)
+				assert.Nil(t, l.tail.next)
+			}
+
+		})
+	}
+}
+
+func TestDLList_All(t *testing.T) {
+	type testCase[T any] struct {
+		name      string
+		l         func() DLList[T]
+		stopAfter int
+		wantIdx   []int
+		wantVals  []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "empty list",
+			l: func() DLList[int] {
+				return DLList[int]{}
+			},
+			stopAfter: -1,
+			wantIdx:   nil,
+			wantVals:  nil,
+		},
+		{
+			name: "full walk",
+			l: func() DLList[int] {
+				l := DLList[int]{}
+				l.Insert(11)
+				l.Insert(12)
+				l.Insert(13)
+				return l
+			},
+			stopAfter: -1,
+			wantIdx:   []int{0, 1, 2},
+			wantVals:  []int{11, 12, 13},
+		},
+		{
+			name: "break early",
+			l: func() DLList[int] {
+				l := DLList[int]{}
+				l.Insert(11)
+				l.Insert(12)
+				l.Insert(13)
+				return l
+			},
+			stopAfter: 1,
+			wantIdx:   []int{0},
+			wantVals:  []int{11},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := tt.l()
+			var gotIdx, gotVals []int
+			for i, v := range l.All() {
+				if len(gotIdx) == tt.stopAfter {
+					break
+				}
+				gotIdx = append(gotIdx, i)
+				gotVals = append(gotVals, v)
+			}
+			assert.Equal(t, tt.wantIdx, gotIdx)
+			assert.Equal(t, tt.wantVals, gotVals)
+		})
+	}
+}
+
+func TestDLList_Values(t *testing.T) {
+	l := DLList[int]{}
+	l.Insert(11)
+	l.Insert(12)
+	l.Insert(13)
+
+	var got []int
+	for v := range l.Values() {
+		got = append(got, v)
+	}
+	assert.Equal(t, []int{11, 12, 13}, got)
+}
+
+func TestDLList_Backward(t *testing.T) {
+	type testCase[T any] struct {
+		name      string
+		l         func() DLList[T]
+		stopAfter int
+		wantIdx   []int
+		wantVals  []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "empty list",
+			l: func() DLList[int] {
+				return DLList[int]{}
+			},
+			stopAfter: -1,
+			wantIdx:   nil,
+			wantVals:  nil,
+		},
+		{
+			name: "full walk",
+			l: func() DLList[int] {
+				l := DLList[int]{}
+				l.Insert(11)
+				l.Insert(12)
+				l.Insert(13)
+				return l
+			},
+			stopAfter: -1,
+			wantIdx:   []int{2, 1, 0},
+			wantVals:  []int{13, 12, 11},
+		},
+		{
+			name: "break early",
+			l: func() DLList[int] {
+				l := DLList[int]{}
+				l.Insert(11)
+				l.Insert(12)
+				l.Insert(13)
+				return l
+			},
+			stopAfter: 2,
+			wantIdx:   []int{2, 1},
+			wantVals:  []int{13, 12},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := tt.l()
+			var gotIdx, gotVals []int
+			for i, v := range l.Backward() {
+				if len(gotIdx) == tt.stopAfter {
+					break
+				}
+				gotIdx = append(gotIdx, i)
+				gotVals = append(gotVals, v)
 			}
+			assert.Equal(t, tt.wantIdx, gotIdx)
+			assert.Equal(t, tt.wantVals, gotVals)
+		})
+	}
+}
 
+func TestDLList_Each(t *testing.T) {
+	type testCase[T any] struct {
+		name     string
+		l        func() DLList[T]
+		stopAt   T
+		wantIdx  []int
+		wantVals []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "empty list",
+			l: func() DLList[int] {
+				return DLList[int]{}
+			},
+			stopAt:   0,
+			wantIdx:  nil,
+			wantVals: nil,
+		},
+		{
+			name: "full walk",
+			l: func() DLList[int] {
+				l := DLList[int]{}
+				l.Insert(11)
+				l.Insert(12)
+				l.Insert(13)
+				return l
+			},
+			stopAt:   -1,
+			wantIdx:  []int{0, 1, 2},
+			wantVals: []int{11, 12, 13},
+		},
+		{
+			name: "stop on match",
+			l: func() DLList[int] {
+				l := DLList[int]{}
+				l.Insert(11)
+				l.Insert(12)
+				l.Insert(13)
+				return l
+			},
+			stopAt:   12,
+			wantIdx:  []int{0, 1},
+			wantVals: []int{11, 12},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := tt.l()
+			var gotIdx, gotVals []int
+			l.Each(func(idx int, v int) bool {
+				gotIdx = append(gotIdx, idx)
+				gotVals = append(gotVals, v)
+				return v != tt.stopAt
+			})
+			assert.Equal(t, tt.wantIdx, gotIdx)
+			assert.Equal(t, tt.wantVals, gotVals)
+		})
+	}
+}
+
+func buildDLList(n int) *DLList[int] {
+	l := &DLList[int]{}
+	for i := 0; i < n; i++ {
+		l.Insert(i)
+	}
+	return l
+}
+
+func BenchmarkDLList_At(b *testing.B) {
+	for _, size := range []int{1_000, 10_000} {
+		l := buildDLList(size)
+		b.Run(fmt.Sprintf("head_quarter_%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = l.At(size / 4)
+			}
+		})
+		b.Run(fmt.Sprintf("tail_quarter_%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = l.At(size - size/4)
+			}
+		})
+	}
+}
+
+func BenchmarkDLList_DeleteFromTail(b *testing.B) {
+	for _, size := range []int{1_000, 10_000} {
+		b.Run(fmt.Sprintf("size_%d", size), func(b *testing.B) {
+			l := buildDLList(size)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = l.DeleteFromTail()
+				l.Insert(i)
+			}
+		})
+	}
+}
+
+func BenchmarkDLList_InsertAtNearTail(b *testing.B) {
+	for _, size := range []int{1_000, 10_000} {
+		b.Run(fmt.Sprintf("size_%d", size), func(b *testing.B) {
+			l := buildDLList(size)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = l.InsertAt(l.Size()-1, i)
+				_ = l.DeleteAt(l.Size() - 2)
+			}
+		})
+	}
+}
+
+func TestDLList_getNodeByIdx(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{1, 2, 5, 6} {
+		l := buildDLList(size)
+		for idx := 0; idx < size; idx++ {
+			assert.Equal(t, idx, l.getNodeByIdx(idx).Value, "size %d idx %d", size, idx)
+		}
+	}
+}
+
+func TestDLList_ToSlice(t *testing.T) {
+	type testCase struct {
+		name string
+		in   []int
+		want []int
+	}
+	tests := []testCase{
+		{
+			name: "empty slice",
+			in:   nil,
+			want: []int{},
+		},
+		{
+			name: "non-empty slice",
+			in:   []int{11, 12, 13},
+			want: []int{11, 12, 13},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := FromSlice(tt.in)
+			checkLinks(t, l, tt.in)
+			assert.Equal(t, tt.want, l.ToSlice())
+			assert.Equal(t, tt.want, From(l.Values()).ToSlice())
 		})
 	}
 }
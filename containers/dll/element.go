@@ -0,0 +1,153 @@
+package dll
+
+// Element is a handle to a value stored in a DLList. It stays valid until
+// the element is removed, so edits around it do not need an index lookup.
+type Element[T any] struct {
+	prev *Element[T]
+	next *Element[T]
+	list *listID
+
+	Value T
+}
+
+// listID tells which list an element belongs to. It is shared by copies
+// of a DLList, so elements stay usable through a list passed by value.
+// It must not be zero-sized, or distinct IDs could share an address.
+type listID struct {
+	_ byte
+}
+
+// Next returns the next element or nil if e is the last one.
+func (e *Element[T]) Next() *Element[T] {
+	return e.next
+}
+
+// Prev returns the previous element or nil if e is the first one.
+func (e *Element[T]) Prev() *Element[T] {
+	return e.prev
+}
+
+// link puts e between prev and next; a nil neighbour means e becomes
+// the head or the tail respectively.
+func (l *DLList[T]) link(e, prev, next *Element[T]) *Element[T] {
+	if l.id == nil {
+		l.id = new(listID)
+	}
+	e.prev, e.next, e.list = prev, next, l.id
+
+	if prev == nil {
+		l.head = e
+	} else {
+		prev.next = e
+	}
+
+	if next == nil {
+		l.tail = e
+	} else {
+		next.prev = e
+	}
+
+	l.size++
+	return e
+}
+
+func (l *DLList[T]) unlink(e *Element[T]) {
+	if e.prev == nil {
+		l.head = e.next
+	} else {
+		e.prev.next = e.next
+	}
+
+	if e.next == nil {
+		l.tail = e.prev
+	} else {
+		e.next.prev = e.prev
+	}
+
+	e.prev, e.next, e.list = nil, nil, nil
+	l.size--
+}
+
+func (l *DLList[T]) owns(e *Element[T]) bool {
+	return e != nil && e.list != nil && e.list == l.id
+}
+
+// Front returns the first element or nil if the list is empty.
+func (l *DLList[T]) Front() *Element[T] {
+	return l.head
+}
+
+// Back returns the last element or nil if the list is empty.
+func (l *DLList[T]) Back() *Element[T] {
+	return l.tail
+}
+
+func (l *DLList[T]) PushFront(v T) *Element[T] {
+	return l.link(&Element[T]{Value: v}, nil, l.head)
+}
+
+func (l *DLList[T]) PushBack(v T) *Element[T] {
+	return l.link(&Element[T]{Value: v}, l.tail, nil)
+}
+
+// InsertBefore inserts v right before mark. It returns nil and leaves the
+// list untouched if mark does not belong to l.
+func (l *DLList[T]) InsertBefore(v T, mark *Element[T]) *Element[T] {
+	if !l.owns(mark) {
+		return nil
+	}
+	return l.link(&Element[T]{Value: v}, mark.prev, mark)
+}
+
+// InsertAfter inserts v right after mark. It returns nil and leaves the
+// list untouched if mark does not belong to l.
+func (l *DLList[T]) InsertAfter(v T, mark *Element[T]) *Element[T] {
+	if !l.owns(mark) {
+		return nil
+	}
+	return l.link(&Element[T]{Value: v}, mark, mark.next)
+}
+
+// Remove unlinks e from l if it belongs to l and returns its value.
+func (l *DLList[T]) Remove(e *Element[T]) T {
+	if l.owns(e) {
+		l.unlink(e)
+	}
+	return e.Value
+}
+
+func (l *DLList[T]) MoveToFront(e *Element[T]) {
+	if !l.owns(e) || l.head == e {
+		return
+	}
+	l.unlink(e)
+	l.link(e, nil, l.head)
+}
+
+func (l *DLList[T]) MoveToBack(e *Element[T]) {
+	if !l.owns(e) || l.tail == e {
+		return
+	}
+	l.unlink(e)
+	l.link(e, l.tail, nil)
+}
+
+// MoveBefore moves e right before mark. Nothing happens if either element
+// does not belong to l or they are the same element.
+func (l *DLList[T]) MoveBefore(e, mark *Element[T]) {
+	if !l.owns(e) || !l.owns(mark) || e == mark {
+		return
+	}
+	l.unlink(e)
+	l.link(e, mark.prev, mark)
+}
+
+// MoveAfter moves e right after mark. Nothing happens if either element
+// does not belong to l or they are the same element.
+func (l *DLList[T]) MoveAfter(e, mark *Element[T]) {
+	if !l.owns(e) || !l.owns(mark) || e == mark {
+		return
+	}
+	l.unlink(e)
+	l.link(e, mark, mark.next)
+}
@@ -0,0 +1,250 @@
+package dll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func checkLinks[T any](t *testing.T, l *DLList[T], want []T) {
+	t.Helper()
+
+	if want == nil {
+		want = []T{}
+	}
+
+	forward, backward := []T{}, []T{}
+	for e := l.Front(); e != nil; e = e.Next() {
+		forward = append(forward, e.Value)
+	}
+	for e := l.Back(); e != nil; e = e.Prev() {
+		backward = append([]T{e.Value}, backward...)
+	}
+
+	assert.Equal(t, want, forward)
+	assert.Equal(t, want, backward)
+	assert.Equal(t, len(want), l.Size())
+}
+
+func TestDLList_PushElements(t *testing.T) {
+	t.Parallel()
+
+	l := DLList[int]{}
+	e2 := l.PushBack(2)
+	e1 := l.PushFront(1)
+	e3 := l.PushBack(3)
+
+	checkLinks(t, &l, []int{1, 2, 3})
+	assert.Equal(t, e1, l.Front())
+	assert.Equal(t, e3, l.Back())
+	assert.Equal(t, e2, e1.Next())
+	assert.Equal(t, e2, e3.Prev())
+	assert.Nil(t, e1.Prev())
+	assert.Nil(t, e3.Next())
+}
+
+func TestDLList_InsertBeforeAfter(t *testing.T) {
+	type testCase struct {
+		name string
+		do   func(l *DLList[int], first, last *Element[int]) *Element[int]
+		want []int
+		nilE bool
+	}
+	tests := []testCase{
+		{
+			name: "before head",
+			do: func(l *DLList[int], first, _ *Element[int]) *Element[int] {
+				return l.InsertBefore(0, first)
+			},
+			want: []int{0, 1, 2},
+		},
+		{
+			name: "before tail",
+			do: func(l *DLList[int], _, last *Element[int]) *Element[int] {
+				return l.InsertBefore(9, last)
+			},
+			want: []int{1, 9, 2},
+		},
+		{
+			name: "after tail",
+			do: func(l *DLList[int], _, last *Element[int]) *Element[int] {
+				return l.InsertAfter(3, last)
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "after head",
+			do: func(l *DLList[int], first, _ *Element[int]) *Element[int] {
+				return l.InsertAfter(9, first)
+			},
+			want: []int{1, 9, 2},
+		},
+		{
+			name: "foreign mark",
+			do: func(l *DLList[int], _, _ *Element[int]) *Element[int] {
+				other := DLList[int]{}
+				return l.InsertAfter(9, other.PushBack(5))
+			},
+			want: []int{1, 2},
+			nilE: true,
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := DLList[int]{}
+			first := l.PushBack(1)
+			last := l.PushBack(2)
+
+			e := tt.do(&l, first, last)
+			if tt.nilE {
+				assert.Nil(t, e)
+			} else {
+				assert.NotNil(t, e)
+			}
+			checkLinks(t, &l, tt.want)
+		})
+	}
+}
+
+func TestDLList_Remove(t *testing.T) {
+	type testCase struct {
+		name string
+		pick func(es []*Element[int]) *Element[int]
+		want []int
+	}
+	tests := []testCase{
+		{
+			name: "remove head",
+			pick: func(es []*Element[int]) *Element[int] { return es[0] },
+			want: []int{2, 3},
+		},
+		{
+			name: "remove middle",
+			pick: func(es []*Element[int]) *Element[int] { return es[1] },
+			want: []int{1, 3},
+		},
+		{
+			name: "remove tail",
+			pick: func(es []*Element[int]) *Element[int] { return es[2] },
+			want: []int{1, 2},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := DLList[int]{}
+			es := []*Element[int]{l.PushBack(1), l.PushBack(2), l.PushBack(3)}
+			e := tt.pick(es)
+
+			got := l.Remove(e)
+			assert.Equal(t, e.Value, got)
+			checkLinks(t, &l, tt.want)
+
+			l.Remove(e)
+			checkLinks(t, &l, tt.want)
+		})
+	}
+
+	t.Run("remove single", func(t *testing.T) {
+		t.Parallel()
+		l := DLList[int]{}
+		l.Remove(l.PushBack(1))
+		checkLinks(t, &l, nil)
+		assert.True(t, l.IsEmpty())
+	})
+}
+
+func TestDLList_Move(t *testing.T) {
+	type testCase struct {
+		name string
+		do   func(l *DLList[int], es []*Element[int])
+		want []int
+	}
+	tests := []testCase{
+		{
+			name: "move tail to front",
+			do:   func(l *DLList[int], es []*Element[int]) { l.MoveToFront(es[3]) },
+			want: []int{4, 1, 2, 3},
+		},
+		{
+			name: "move head to front",
+			do:   func(l *DLList[int], es []*Element[int]) { l.MoveToFront(es[0]) },
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			name: "move head to back",
+			do:   func(l *DLList[int], es []*Element[int]) { l.MoveToBack(es[0]) },
+			want: []int{2, 3, 4, 1},
+		},
+		{
+			name: "move before",
+			do:   func(l *DLList[int], es []*Element[int]) { l.MoveBefore(es[3], es[1]) },
+			want: []int{1, 4, 2, 3},
+		},
+		{
+			name: "move after",
+			do:   func(l *DLList[int], es []*Element[int]) { l.MoveAfter(es[0], es[2]) },
+			want: []int{2, 3, 1, 4},
+		},
+		{
+			name: "move after itself",
+			do:   func(l *DLList[int], es []*Element[int]) { l.MoveAfter(es[1], es[1]) },
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			name: "move foreign element",
+			do: func(l *DLList[int], _ []*Element[int]) {
+				other := DLList[int]{}
+				l.MoveToFront(other.PushBack(9))
+			},
+			want: []int{1, 2, 3, 4},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := DLList[int]{}
+			es := []*Element[int]{l.PushBack(1), l.PushBack(2), l.PushBack(3), l.PushBack(4)}
+
+			tt.do(&l, es)
+			checkLinks(t, &l, tt.want)
+		})
+	}
+}
+
+func TestDLList_ElementsOfCopy(t *testing.T) {
+	t.Parallel()
+
+	build := func() DLList[int] {
+		l := DLList[int]{}
+		l.PushBack(1)
+		l.PushBack(2)
+		l.PushBack(3)
+		return l
+	}
+
+	l := build()
+	l.MoveToFront(l.Back())
+	checkLinks(t, &l, []int{3, 1, 2})
+
+	assert.NotNil(t, l.InsertAfter(9, l.Front()))
+	checkLinks(t, &l, []int{3, 9, 1, 2})
+
+	assert.Equal(t, 3, l.Remove(l.Front()))
+	checkLinks(t, &l, []int{9, 1, 2})
+
+	other := build()
+	l.MoveToFront(other.Back())
+	checkLinks(t, &l, []int{9, 1, 2})
+	checkLinks(t, &other, []int{1, 2, 3})
+}
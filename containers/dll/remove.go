@@ -0,0 +1,65 @@
+package dll
+
+// RemoveFunc deletes every value satisfying pred in a single pass and
+// returns how many values were removed.
+func (l *DLList[T]) RemoveFunc(pred func(v T) bool) int {
+	removed := 0
+
+	for current := l.head; current != nil; {
+		next := current.next
+		if pred(current.Value) {
+			l.unlink(current)
+			removed++
+		}
+		current = next
+	}
+
+	return removed
+}
+
+// Retain keeps only the values satisfying pred and returns how many values
+// were removed.
+func (l *DLList[T]) Retain(pred func(v T) bool) int {
+	return l.RemoveFunc(func(v T) bool { return !pred(v) })
+}
+
+// Clear removes all values from the list. Elements that were in the list
+// are detached, so stale handles can no longer modify it.
+func (l *DLList[T]) Clear() {
+	detach(l.head)
+	l.head, l.tail, l.size = nil, nil, 0
+}
+
+// Truncate keeps the first n values and drops the rest. A n bigger than
+// the list size leaves the list untouched.
+func (l *DLList[T]) Truncate(n int) error {
+	if n < 0 {
+		return ErrIndexIsOutOfSize
+	}
+
+	if n >= l.size {
+		return nil
+	}
+
+	if n == 0 {
+		l.Clear()
+		return nil
+	}
+
+	last := l.getNodeByIdx(n - 1)
+	detach(last.next)
+	last.next = nil
+	l.tail = last
+	l.size = n
+
+	return nil
+}
+
+// detach clears the links of e and every element after it.
+func detach[T any](e *Element[T]) {
+	for e != nil {
+		next := e.next
+		e.prev, e.next, e.list = nil, nil, nil
+		e = next
+	}
+}
@@ -0,0 +1,70 @@
+package dll
+
+// IndexFunc returns the index of the first value satisfying pred, or -1.
+func (l *DLList[T]) IndexFunc(pred func(v T) bool) int {
+	idx := 0
+	for current := l.head; current != nil; current = current.next {
+		if pred(current.Value) {
+			return idx
+		}
+		idx++
+	}
+	return -1
+}
+
+// Find returns the first value satisfying pred.
+func (l *DLList[T]) Find(pred func(v T) bool) (T, bool) {
+	for current := l.head; current != nil; current = current.next {
+		if pred(current.Value) {
+			return current.Value, true
+		}
+	}
+
+	var tNil T
+	return tNil, false
+}
+
+// ContainsFunc reports whether at least one value satisfies pred.
+func (l *DLList[T]) ContainsFunc(pred func(v T) bool) bool {
+	return l.IndexFunc(pred) >= 0
+}
+
+// LastIndexFunc returns the index of the last value satisfying pred, or -1.
+// The list is scanned backward from the tail.
+func (l *DLList[T]) LastIndexFunc(pred func(v T) bool) int {
+	idx := l.size - 1
+	for current := l.tail; current != nil; current = current.prev {
+		if pred(current.Value) {
+			return idx
+		}
+		idx--
+	}
+	return -1
+}
+
+// FindLast returns the last value satisfying pred.
+func (l *DLList[T]) FindLast(pred func(v T) bool) (T, bool) {
+	for current := l.tail; current != nil; current = current.prev {
+		if pred(current.Value) {
+			return current.Value, true
+		}
+	}
+
+	var tNil T
+	return tNil, false
+}
+
+// Index returns the index of the first occurrence of v in l, or -1.
+func Index[T comparable](l *DLList[T], v T) int {
+	return l.IndexFunc(func(x T) bool { return x == v })
+}
+
+// Contains reports whether v is present in l.
+func Contains[T comparable](l *DLList[T], v T) bool {
+	return Index(l, v) >= 0
+}
+
+// LastIndex returns the index of the last occurrence of v in l, or -1.
+func LastIndex[T comparable](l *DLList[T], v T) int {
+	return l.LastIndexFunc(func(x T) bool { return x == v })
+}
@@ -0,0 +1,87 @@
+package dll
+
+import "cmp"
+
+// SortFunc sorts the list in place with a stable merge sort over the
+// elements. Elements are relinked rather than copied, so handles obtained
+// before the sort keep pointing at their values.
+func (l *DLList[T]) SortFunc(compare func(a, b T) int) {
+	if l.size < 2 {
+		return
+	}
+
+	l.head = mergeSort(l.head, compare)
+
+	var prev *Element[T]
+	for current := l.head; current != nil; current = current.next {
+		current.prev = prev
+		prev = current
+	}
+	l.tail = prev
+}
+
+// IsSortedFunc reports whether the list is sorted according to compare.
+func (l *DLList[T]) IsSortedFunc(compare func(a, b T) int) bool {
+	if l.head == nil {
+		return true
+	}
+
+	for current := l.head; current.next != nil; current = current.next {
+		if compare(current.next.Value, current.Value) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Sort sorts l in ascending order.
+func Sort[T cmp.Ordered](l *DLList[T]) {
+	l.SortFunc(cmp.Compare[T])
+}
+
+// IsSorted reports whether l is sorted in ascending order.
+func IsSorted[T cmp.Ordered](l *DLList[T]) bool {
+	return l.IsSortedFunc(cmp.Compare[T])
+}
+
+// mergeSort sorts the chain starting at head using the next links only;
+// the caller restores prev links afterwards.
+func mergeSort[T any](head *Element[T], compare func(a, b T) int) *Element[T] {
+	if head == nil || head.next == nil {
+		return head
+	}
+
+	slow, fast := head, head.next
+	for fast != nil && fast.next != nil {
+		slow, fast = slow.next, fast.next.next
+	}
+
+	right := slow.next
+	slow.next = nil
+
+	return merge(mergeSort(head, compare), mergeSort(right, compare), compare)
+}
+
+func merge[T any](left, right *Element[T], compare func(a, b T) int) *Element[T] {
+	var dummy Element[T]
+	current := &dummy
+
+	for left != nil && right != nil {
+		// Taking from the left run on ties keeps the sort stable.
+		if compare(right.Value, left.Value) < 0 {
+			current.next, right = right, right.next
+		} else {
+			current.next, left = left, left.next
+		}
+		current = current.next
+	}
+
+	if left != nil {
+		current.next = left
+	} else {
+		current.next = right
+	}
+
+	return dummy.next
+}
@@ -0,0 +1,78 @@
+package dll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDLList_Sort(t *testing.T) {
+	type testCase struct {
+		name string
+		in   []int
+		want []int
+	}
+	tests := []testCase{
+		{
+			name: "empty list",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "single element",
+			in:   []int{1},
+			want: []int{1},
+		},
+		{
+			name: "reversed",
+			in:   []int{5, 4, 3, 2, 1},
+			want: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name: "with duplicates",
+			in:   []int{3, 1, 2, 3, 1, 0},
+			want: []int{0, 1, 1, 2, 3, 3},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := DLList[int]{}
+			for _, v := range tt.in {
+				l.Insert(v)
+			}
+
+			Sort(&l)
+
+			checkLinks(t, &l, tt.want)
+			assert.True(t, IsSorted(&l))
+		})
+	}
+}
+
+func TestDLList_SortFunc(t *testing.T) {
+	t.Parallel()
+
+	type pair struct {
+		key int
+		pos int
+	}
+	l := DLList[pair]{}
+	var handles []*Element[pair]
+	for i, k := range []int{2, 1, 2, 1, 0, 2} {
+		handles = append(handles, l.PushBack(pair{key: k, pos: i}))
+	}
+
+	byKey := func(a, b pair) int { return a.key - b.key }
+	assert.False(t, l.IsSortedFunc(byKey))
+
+	l.SortFunc(byKey)
+
+	checkLinks(t, &l, []pair{{0, 4}, {1, 1}, {1, 3}, {2, 0}, {2, 2}, {2, 5}})
+	assert.True(t, l.IsSortedFunc(byKey))
+	assert.Equal(t, handles[4], l.Front())
+	assert.Equal(t, handles[5], l.Back())
+}
@@ -0,0 +1,167 @@
+package heap
+
+import "cmp"
+
+// Handle points at a value stored in a Heap. After changing Value the
+// caller must call Heap.Fix to restore the heap order.
+type Handle[T any] struct {
+	Value T
+
+	// index is the position in Heap.items, or -1 once the value is removed.
+	index int
+}
+
+// Heap is a binary heap ordered by less: Pop returns the value for which
+// less reports true against every other value.
+type Heap[T any] struct {
+	items []*Handle[T]
+	less  func(a, b T) bool
+}
+
+func New[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less}
+}
+
+// FromSlice builds a heap from the values of s in O(n).
+func FromSlice[T any](s []T, less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{
+		items: make([]*Handle[T], len(s)),
+		less:  less,
+	}
+
+	for i, v := range s {
+		h.items[i] = &Handle[T]{Value: v, index: i}
+	}
+
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+
+	return h
+}
+
+func NewMin[T cmp.Ordered]() *Heap[T] {
+	return New(cmp.Less[T])
+}
+
+func NewMax[T cmp.Ordered]() *Heap[T] {
+	return New(func(a, b T) bool { return cmp.Less(b, a) })
+}
+
+func (h *Heap[T]) Push(v T) {
+	h.PushHandle(v)
+}
+
+// PushHandle adds v and returns a handle that can later be passed to Fix
+// or Remove.
+func (h *Heap[T]) PushHandle(v T) *Handle[T] {
+	e := &Handle[T]{Value: v, index: len(h.items)}
+	h.items = append(h.items, e)
+	h.up(e.index)
+	return e
+}
+
+func (h *Heap[T]) Pop() (T, bool) {
+	if len(h.items) == 0 {
+		var tNil T
+		return tNil, false
+	}
+	return h.removeAt(0), true
+}
+
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		var tNil T
+		return tNil, false
+	}
+	return h.items[0].Value, true
+}
+
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// Fix restores the heap order after e.Value has been changed.
+// It does nothing if e is no longer in the heap.
+func (h *Heap[T]) Fix(e *Handle[T]) {
+	if !h.owns(e) {
+		return
+	}
+
+	if !h.down(e.index) {
+		h.up(e.index)
+	}
+}
+
+// Remove deletes e from the heap and returns its value.
+func (h *Heap[T]) Remove(e *Handle[T]) T {
+	if !h.owns(e) {
+		return e.Value
+	}
+	return h.removeAt(e.index)
+}
+
+func (h *Heap[T]) owns(e *Handle[T]) bool {
+	return e != nil && e.index >= 0 && e.index < len(h.items) && h.items[e.index] == e
+}
+
+func (h *Heap[T]) removeAt(idx int) T {
+	last := len(h.items) - 1
+	e := h.items[idx]
+
+	if idx != last {
+		h.swap(idx, last)
+	}
+	h.items[last] = nil
+	h.items = h.items[:last]
+
+	if idx != last {
+		if !h.down(idx) {
+			h.up(idx)
+		}
+	}
+
+	e.index = -1
+	return e.Value
+}
+
+func (h *Heap[T]) up(idx int) {
+	for idx > 0 {
+		parent := (idx - 1) / 2
+		if !h.less(h.items[idx].Value, h.items[parent].Value) {
+			return
+		}
+		h.swap(idx, parent)
+		idx = parent
+	}
+}
+
+// down sifts the value at idx towards the leaves and reports whether it
+// moved.
+func (h *Heap[T]) down(idx int) bool {
+	start := idx
+	n := len(h.items)
+
+	for {
+		child := 2*idx + 1
+		if child >= n {
+			break
+		}
+		if right := child + 1; right < n && h.less(h.items[right].Value, h.items[child].Value) {
+			child = right
+		}
+		if !h.less(h.items[child].Value, h.items[idx].Value) {
+			break
+		}
+		h.swap(idx, child)
+		idx = child
+	}
+
+	return idx > start
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
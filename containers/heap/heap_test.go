@@ -0,0 +1,186 @@
+package heap
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers"
+)
+
+var _ containers.PriorityQueue[int] = (*Heap[int])(nil)
+
+func drain[T any](h *Heap[T]) []T {
+	var got []T
+	for h.Len() > 0 {
+		v, _ := h.Pop()
+		got = append(got, v)
+	}
+	return got
+}
+
+func TestHeap_PushPop(t *testing.T) {
+	type testCase struct {
+		name string
+		h    func() *Heap[int]
+		in   []int
+		want []int
+	}
+	tests := []testCase{
+		{
+			name: "empty min heap",
+			h:    NewMin[int],
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "min heap",
+			h:    NewMin[int],
+			in:   []int{5, 3, 8, 1, 9, 1},
+			want: []int{1, 1, 3, 5, 8, 9},
+		},
+		{
+			name: "max heap",
+			h:    NewMax[int],
+			in:   []int{5, 3, 8, 1, 9, 1},
+			want: []int{9, 8, 5, 3, 1, 1},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := tt.h()
+			for _, v := range tt.in {
+				h.Push(v)
+			}
+			assert.Equal(t, len(tt.in), h.Len())
+
+			if len(tt.want) > 0 {
+				top, ok := h.Peek()
+				assert.True(t, ok)
+				assert.Equal(t, tt.want[0], top)
+			}
+			assert.Equal(t, tt.want, drain(h))
+
+			_, ok := h.Pop()
+			assert.False(t, ok)
+			_, ok = h.Peek()
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestFromSlice(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	in := make([]int, 200)
+	for i := range in {
+		in[i] = r.Intn(50)
+	}
+
+	h := FromSlice(in, func(a, b int) bool { return a < b })
+	want := slices.Clone(in)
+	slices.Sort(want)
+	assert.Equal(t, want, drain(h))
+}
+
+func TestHeap_Fix(t *testing.T) {
+	t.Parallel()
+
+	h := NewMin[int]()
+	handles := make(map[int]*Handle[int])
+	for _, v := range []int{10, 20, 30, 40, 50} {
+		handles[v] = h.PushHandle(v)
+	}
+
+	handles[40].Value = 1
+	h.Fix(handles[40])
+	handles[10].Value = 45
+	h.Fix(handles[10])
+
+	assert.Equal(t, []int{1, 20, 30, 45, 50}, drain(h))
+
+	// A popped handle is no longer owned by the heap.
+	handles[20].Value = 0
+	h.Fix(handles[20])
+	assert.Equal(t, 0, h.Len())
+}
+
+func TestHeap_Remove(t *testing.T) {
+	type testCase struct {
+		name   string
+		remove []int
+		want   []int
+	}
+	tests := []testCase{
+		{
+			name:   "remove top",
+			remove: []int{1},
+			want:   []int{2, 3, 4, 5, 6},
+		},
+		{
+			name:   "remove last",
+			remove: []int{6},
+			want:   []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:   "remove middle twice",
+			remove: []int{3, 3},
+			want:   []int{1, 2, 4, 5, 6},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := NewMin[int]()
+			handles := make(map[int]*Handle[int])
+			for _, v := range []int{4, 6, 1, 3, 5, 2} {
+				handles[v] = h.PushHandle(v)
+			}
+
+			for _, v := range tt.remove {
+				assert.Equal(t, v, h.Remove(handles[v]))
+			}
+			assert.Equal(t, tt.want, drain(h))
+		})
+	}
+}
+
+func TestHeap_Random(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(2))
+	h := NewMin[int]()
+	var handles []*Handle[int]
+	var want []int
+
+	for i := 0; i < 1_000; i++ {
+		switch op := r.Intn(3); {
+		case op == 0 && len(handles) > 0:
+			j := r.Intn(len(handles))
+			h.Remove(handles[j])
+			handles = slices.Delete(handles, j, j+1)
+		case op == 1 && len(handles) > 0:
+			e := handles[r.Intn(len(handles))]
+			e.Value = r.Intn(1_000)
+			h.Fix(e)
+		default:
+			handles = append(handles, h.PushHandle(r.Intn(1_000)))
+		}
+	}
+
+	for _, e := range handles {
+		want = append(want, e.Value)
+	}
+	slices.Sort(want)
+	assert.Equal(t, want, drain(h))
+}
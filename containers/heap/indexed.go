@@ -0,0 +1,117 @@
+package heap
+
+import (
+	"cmp"
+	"errors"
+)
+
+var (
+	ErrKeyNotFound = errors.New("key not found")
+	ErrNotDecrease = errors.New("priority is not lower than the current one")
+)
+
+type entry[K comparable, P any] struct {
+	key      K
+	priority P
+}
+
+// Indexed is a priority queue of unique keys whose priorities can be
+// changed in O(log n), as needed by Dijkstra or Prim.
+type Indexed[K comparable, P any] struct {
+	heap    *Heap[entry[K, P]]
+	handles map[K]*Handle[entry[K, P]]
+	less    func(a, b P) bool
+}
+
+// NewIndexed returns a queue that pops the key with the lowest priority
+// according to less.
+func NewIndexed[K comparable, P any](less func(a, b P) bool) *Indexed[K, P] {
+	return &Indexed[K, P]{
+		heap: New(func(a, b entry[K, P]) bool {
+			return less(a.priority, b.priority)
+		}),
+		handles: make(map[K]*Handle[entry[K, P]]),
+		less:    less,
+	}
+}
+
+func NewIndexedMin[K comparable, P cmp.Ordered]() *Indexed[K, P] {
+	return NewIndexed[K](cmp.Less[P])
+}
+
+// Upsert adds key with the given priority or changes the priority of a
+// key that is already queued.
+func (q *Indexed[K, P]) Upsert(key K, priority P) {
+	if h, ok := q.handles[key]; ok {
+		h.Value.priority = priority
+		q.heap.Fix(h)
+		return
+	}
+
+	q.handles[key] = q.heap.PushHandle(entry[K, P]{key: key, priority: priority})
+}
+
+// DecreaseKey lowers the priority of a queued key. It fails if the key is
+// missing or priority is not lower than the current one.
+func (q *Indexed[K, P]) DecreaseKey(key K, priority P) error {
+	h, ok := q.handles[key]
+	if !ok {
+		return ErrKeyNotFound
+	}
+
+	if !q.less(priority, h.Value.priority) {
+		return ErrNotDecrease
+	}
+
+	h.Value.priority = priority
+	q.heap.Fix(h)
+
+	return nil
+}
+
+// Delete removes key from the queue and reports whether it was present.
+func (q *Indexed[K, P]) Delete(key K) bool {
+	h, ok := q.handles[key]
+	if !ok {
+		return false
+	}
+
+	q.heap.Remove(h)
+	delete(q.handles, key)
+
+	return true
+}
+
+func (q *Indexed[K, P]) Contains(key K) bool {
+	_, ok := q.handles[key]
+	return ok
+}
+
+// Priority returns the current priority of key.
+func (q *Indexed[K, P]) Priority(key K) (P, bool) {
+	h, ok := q.handles[key]
+	if !ok {
+		var pNil P
+		return pNil, false
+	}
+	return h.Value.priority, true
+}
+
+// PeekMin returns the key with the lowest priority without removing it.
+func (q *Indexed[K, P]) PeekMin() (K, P, bool) {
+	e, ok := q.heap.Peek()
+	return e.key, e.priority, ok
+}
+
+// PopMin removes and returns the key with the lowest priority.
+func (q *Indexed[K, P]) PopMin() (K, P, bool) {
+	e, ok := q.heap.Pop()
+	if ok {
+		delete(q.handles, e.key)
+	}
+	return e.key, e.priority, ok
+}
+
+func (q *Indexed[K, P]) Len() int {
+	return q.heap.Len()
+}
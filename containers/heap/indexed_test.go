@@ -0,0 +1,139 @@
+package heap
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers"
+)
+
+var _ containers.IndexedPriorityQueue[string, int] = (*Indexed[string, int])(nil)
+
+func TestIndexed_Upsert(t *testing.T) {
+	t.Parallel()
+
+	q := NewIndexedMin[string, int]()
+	q.Upsert("a", 5)
+	q.Upsert("b", 3)
+	q.Upsert("c", 4)
+	q.Upsert("a", 1)
+	q.Upsert("b", 10)
+
+	assert.Equal(t, 3, q.Len())
+	p, ok := q.Priority("b")
+	assert.True(t, ok)
+	assert.Equal(t, 10, p)
+
+	var keys []string
+	for q.Len() > 0 {
+		k, _, _ := q.PopMin()
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"a", "c", "b"}, keys)
+	assert.False(t, q.Contains("a"))
+
+	_, _, ok = q.PopMin()
+	assert.False(t, ok)
+}
+
+func TestIndexed_DecreaseKey(t *testing.T) {
+	type testCase struct {
+		name     string
+		key      string
+		priority int
+		wantErr  error
+		wantMin  string
+	}
+	tests := []testCase{
+		{
+			name:     "missing key",
+			key:      "z",
+			priority: 0,
+			wantErr:  ErrKeyNotFound,
+			wantMin:  "a",
+		},
+		{
+			name:     "increase is rejected",
+			key:      "a",
+			priority: 100,
+			wantErr:  ErrNotDecrease,
+			wantMin:  "a",
+		},
+		{
+			name:     "decrease to new minimum",
+			key:      "c",
+			priority: 0,
+			wantErr:  nil,
+			wantMin:  "c",
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			q := NewIndexedMin[string, int]()
+			q.Upsert("a", 1)
+			q.Upsert("b", 2)
+			q.Upsert("c", 3)
+
+			assert.ErrorIs(t, q.DecreaseKey(tt.key, tt.priority), tt.wantErr)
+			k, _, ok := q.PeekMin()
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantMin, k)
+		})
+	}
+}
+
+func TestIndexed_Delete(t *testing.T) {
+	t.Parallel()
+
+	q := NewIndexedMin[string, int]()
+	q.Upsert("a", 1)
+	q.Upsert("b", 2)
+
+	assert.True(t, q.Delete("a"))
+	assert.False(t, q.Delete("a"))
+	assert.False(t, q.Contains("a"))
+	assert.True(t, q.Contains("b"))
+
+	k, p, ok := q.PopMin()
+	assert.Equal(t, "b", k)
+	assert.Equal(t, 2, p)
+	assert.True(t, ok)
+}
+
+func TestIndexed_Dijkstra(t *testing.T) {
+	t.Parallel()
+
+	graph := map[string]map[string]int{
+		"a": {"b": 7, "c": 9, "f": 14},
+		"b": {"a": 7, "c": 10, "d": 15},
+		"c": {"a": 9, "b": 10, "d": 11, "f": 2},
+		"d": {"b": 15, "c": 11, "e": 6},
+		"e": {"d": 6, "f": 9},
+		"f": {"a": 14, "c": 2, "e": 9},
+	}
+
+	dist := make(map[string]int)
+	q := NewIndexedMin[string, int]()
+	for v := range graph {
+		q.Upsert(v, math.MaxInt)
+	}
+	assert.NoError(t, q.DecreaseKey("a", 0))
+
+	for q.Len() > 0 {
+		u, d, _ := q.PopMin()
+		dist[u] = d
+		for v, w := range graph[u] {
+			if cur, ok := q.Priority(v); ok && d+w < cur {
+				assert.NoError(t, q.DecreaseKey(v, d+w))
+			}
+		}
+	}
+
+	assert.Equal(t, map[string]int{"a": 0, "b": 7, "c": 9, "d": 20, "e": 20, "f": 11}, dist)
+}
@@ -0,0 +1,90 @@
+package lockfree
+
+import "sync/atomic"
+
+type node[T any] struct {
+	next atomic.Pointer[node[T]]
+	val  T
+}
+
+// Queue is a non-blocking multi-producer multi-consumer FIFO queue after
+// Michael and Scott. head always points at a dummy node; the first value
+// lives in head.next. Use NewQueue to create one.
+type Queue[T any] struct {
+	head atomic.Pointer[node[T]]
+	tail atomic.Pointer[node[T]]
+	size atomic.Int64
+}
+
+func NewQueue[T any]() *Queue[T] {
+	q := &Queue[T]{}
+	dummy := &node[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+func (q *Queue[T]) Enqueue(v T) {
+	n := &node[T]{val: v}
+
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+
+		if tail != q.tail.Load() {
+			continue
+		}
+
+		if next != nil {
+			// Another producer linked a node but has not swung the tail
+			// yet; help it along before retrying.
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		if tail.next.CompareAndSwap(nil, n) {
+			q.tail.CompareAndSwap(tail, n)
+			q.size.Add(1)
+			return
+		}
+	}
+}
+
+// TryDequeue removes and returns the oldest value. It reports false
+// without blocking if the queue is empty.
+func (q *Queue[T]) TryDequeue() (T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+
+		if head != q.head.Load() {
+			continue
+		}
+
+		if next == nil {
+			var tNil T
+			return tNil, false
+		}
+
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		// next becomes the new dummy and keeps its value reachable until
+		// the following dequeue; clearing it here would race with readers
+		// that loaded the same head.
+		v := next.val
+		if q.head.CompareAndSwap(head, next) {
+			q.size.Add(-1)
+			return v, true
+		}
+	}
+}
+
+// Len returns the number of values in the queue. Under concurrent use the
+// result is only an approximation.
+func (q *Queue[T]) Len() int {
+	return int(max(q.size.Load(), 0))
+}
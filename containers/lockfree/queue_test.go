@@ -0,0 +1,188 @@
+package lockfree
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers/sll"
+)
+
+func TestQueue_FIFO(t *testing.T) {
+	type testCase struct {
+		name    string
+		push    []int
+		pops    int
+		want    []int
+		wantOk  []bool
+		wantLen int
+	}
+	tests := []testCase{
+		{
+			name:    "empty queue",
+			push:    nil,
+			pops:    1,
+			want:    []int{0},
+			wantOk:  []bool{false},
+			wantLen: 0,
+		},
+		{
+			name:    "first in first out",
+			push:    []int{1, 2, 3},
+			pops:    2,
+			want:    []int{1, 2},
+			wantOk:  []bool{true, true},
+			wantLen: 1,
+		},
+		{
+			name:    "drain past the end",
+			push:    []int{1},
+			pops:    2,
+			want:    []int{1, 0},
+			wantOk:  []bool{true, false},
+			wantLen: 0,
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			q := NewQueue[int]()
+			for _, v := range tt.push {
+				q.Enqueue(v)
+			}
+
+			var got []int
+			var gotOk []bool
+			for i := 0; i < tt.pops; i++ {
+				v, ok := q.TryDequeue()
+				got = append(got, v)
+				gotOk = append(gotOk, ok)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, gotOk)
+			assert.Equal(t, tt.wantLen, q.Len())
+		})
+	}
+}
+
+func TestQueue_Stress(t *testing.T) {
+	const (
+		producers = 8
+		consumers = 8
+		perProd   = 2_000
+	)
+
+	t.Parallel()
+
+	type item struct {
+		producer int
+		seq      int
+	}
+	q := NewQueue[item]()
+
+	var prodWG sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		prodWG.Add(1)
+		go func(p int) {
+			defer prodWG.Done()
+			for i := 0; i < perProd; i++ {
+				q.Enqueue(item{producer: p, seq: i})
+			}
+		}(p)
+	}
+
+	done := make(chan struct{})
+	results := make([][]item, consumers)
+	var consWG sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		consWG.Add(1)
+		go func(c int) {
+			defer consWG.Done()
+			for {
+				v, ok := q.TryDequeue()
+				if ok {
+					results[c] = append(results[c], v)
+					continue
+				}
+				select {
+				case <-done:
+					// Producers are finished; drain whatever is left.
+					for v, ok := q.TryDequeue(); ok; v, ok = q.TryDequeue() {
+						results[c] = append(results[c], v)
+					}
+					return
+				default:
+				}
+			}
+		}(c)
+	}
+
+	prodWG.Wait()
+	close(done)
+	consWG.Wait()
+
+	seen := make(map[item]bool, producers*perProd)
+	for _, got := range results {
+		last := make(map[int]int)
+		for _, v := range got {
+			assert.False(t, seen[v], "value %v dequeued twice", v)
+			seen[v] = true
+
+			// A single consumer must see each producer's values in order.
+			if prev, ok := last[v.producer]; ok {
+				assert.Less(t, prev, v.seq)
+			}
+			last[v.producer] = v.seq
+		}
+	}
+	assert.Len(t, seen, producers*perProd)
+	assert.Equal(t, 0, q.Len())
+}
+
+type mutexList struct {
+	mu   sync.Mutex
+	list sll.SLList[int]
+}
+
+func (m *mutexList) Enqueue(v int) {
+	m.mu.Lock()
+	m.list.Insert(v)
+	m.mu.Unlock()
+}
+
+func (m *mutexList) TryDequeue() (int, bool) {
+	m.mu.Lock()
+	v, err := m.list.PopFront()
+	m.mu.Unlock()
+	return v, err == nil
+}
+
+func BenchmarkQueue_Parallel(b *testing.B) {
+	impls := []struct {
+		name string
+		q    interface {
+			Enqueue(v int)
+			TryDequeue() (int, bool)
+		}
+	}{
+		{name: "lockfree", q: NewQueue[int]()},
+		{name: "mutex_sll", q: &mutexList{}},
+	}
+
+	for _, impl := range impls {
+		b.Run(impl.name, func(b *testing.B) {
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					impl.q.Enqueue(i)
+					_, _ = impl.q.TryDequeue()
+					i++
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package lru
+
+import "github.com/ivdaria/go-containers/containers/dll"
+
+type entry[K comparable, V any] struct {
+	key K
+	val V
+}
+
+// Stats counts the lookups done through Get.
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// Cache is a fixed-capacity least recently used cache. Entries live in a
+// doubly linked list ordered from the most to the least recently used, so
+// every operation is O(1). Cache is not safe for concurrent use; see
+// Synced for that.
+type Cache[K comparable, V any] struct {
+	capacity int
+	items    map[K]*dll.Element[entry[K, V]]
+	order    dll.DLList[entry[K, V]]
+	onEvict  func(key K, val V)
+	stats    Stats
+}
+
+// New returns a cache holding at most capacity entries.
+// It panics if capacity is not positive.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	return NewWithEvict[K, V](capacity, nil)
+}
+
+// NewWithEvict is like New but calls onEvict for every entry dropped to
+// make room, either by Put or by Resize. Explicit removals do not trigger
+// it.
+func NewWithEvict[K comparable, V any](capacity int, onEvict func(key K, val V)) *Cache[K, V] {
+	if capacity <= 0 {
+		panic("lru: capacity must be positive")
+	}
+
+	return &Cache[K, V]{
+		capacity: capacity,
+		items:    make(map[K]*dll.Element[entry[K, V]], capacity),
+		onEvict:  onEvict,
+	}
+}
+
+// Get returns the value for key and marks it as the most recently used.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var vNil V
+		return vNil, false
+	}
+
+	c.stats.Hits++
+	c.order.MoveToFront(e)
+
+	return e.Value.val, true
+}
+
+// Peek returns the value for key without touching its recency or the
+// statistics.
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		var vNil V
+		return vNil, false
+	}
+	return e.Value.val, true
+}
+
+// Put stores val under key as the most recently used entry and reports
+// whether another entry had to be evicted.
+func (c *Cache[K, V]) Put(key K, val V) bool {
+	if e, ok := c.items[key]; ok {
+		e.Value.val = val
+		c.order.MoveToFront(e)
+		return false
+	}
+
+	c.items[key] = c.order.PushFront(entry[K, V]{key: key, val: val})
+
+	return c.evict(c.capacity) > 0
+}
+
+// Remove deletes key and reports whether it was present.
+func (c *Cache[K, V]) Remove(key K) bool {
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+
+	c.order.Remove(e)
+	delete(c.items, key)
+
+	return true
+}
+
+// Resize changes the capacity and returns how many entries were evicted
+// to fit into it. It panics if capacity is not positive.
+func (c *Cache[K, V]) Resize(capacity int) int {
+	if capacity <= 0 {
+		panic("lru: capacity must be positive")
+	}
+
+	c.capacity = capacity
+	return c.evict(capacity)
+}
+
+// Oldest returns the least recently used entry without touching it.
+func (c *Cache[K, V]) Oldest() (K, V, bool) {
+	e := c.order.Back()
+	if e == nil {
+		var (
+			kNil K
+			vNil V
+		)
+		return kNil, vNil, false
+	}
+	return e.Value.key, e.Value.val, true
+}
+
+// Keys returns the keys from the most to the least recently used.
+func (c *Cache[K, V]) Keys() []K {
+	keys := make([]K, 0, c.order.Size())
+	for e := c.order.Front(); e != nil; e = e.Next() {
+		keys = append(keys, e.Value.key)
+	}
+	return keys
+}
+
+func (c *Cache[K, V]) Len() int {
+	return c.order.Size()
+}
+
+func (c *Cache[K, V]) Cap() int {
+	return c.capacity
+}
+
+func (c *Cache[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// Purge removes every entry without calling the eviction callback.
+func (c *Cache[K, V]) Purge() {
+	c.order.Clear()
+	clear(c.items)
+}
+
+// evict drops least recently used entries until at most limit remain.
+func (c *Cache[K, V]) evict(limit int) int {
+	evicted := 0
+
+	for c.order.Size() > limit {
+		e := c.order.Back()
+		c.order.Remove(e)
+		delete(c.items, e.Value.key)
+		evicted++
+
+		if c.onEvict != nil {
+			c.onEvict(e.Value.key, e.Value.val)
+		}
+	}
+
+	return evicted
+}
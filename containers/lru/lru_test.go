@@ -0,0 +1,161 @@
+package lru
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type evicted struct {
+	key string
+	val int
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() { New[string, int](0) })
+	assert.Equal(t, 2, New[string, int](2).Cap())
+}
+
+func TestCache_PutGet(t *testing.T) {
+	type testCase struct {
+		name        string
+		do          func(c *Cache[string, int])
+		wantKeys    []string
+		wantEvicted []evicted
+	}
+	tests := []testCase{
+		{
+			name: "evicts least recently put",
+			do: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Put("d", 4)
+			},
+			wantKeys:    []string{"d", "c", "b"},
+			wantEvicted: []evicted{{"a", 1}},
+		},
+		{
+			name: "get refreshes recency",
+			do: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Get("a")
+				c.Put("d", 4)
+			},
+			wantKeys:    []string{"d", "a", "c"},
+			wantEvicted: []evicted{{"b", 2}},
+		},
+		{
+			name: "peek does not refresh recency",
+			do: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("c", 3)
+				c.Peek("a")
+				c.Put("d", 4)
+			},
+			wantKeys:    []string{"d", "c", "b"},
+			wantEvicted: []evicted{{"a", 1}},
+		},
+		{
+			name: "update existing key",
+			do: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Put("a", 10)
+			},
+			wantKeys:    []string{"a", "b"},
+			wantEvicted: nil,
+		},
+		{
+			name: "remove does not call back",
+			do: func(c *Cache[string, int]) {
+				c.Put("a", 1)
+				c.Put("b", 2)
+				c.Remove("a")
+				c.Remove("z")
+			},
+			wantKeys:    []string{"b"},
+			wantEvicted: nil,
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []evicted
+			c := NewWithEvict(3, func(key string, val int) {
+				got = append(got, evicted{key, val})
+			})
+
+			tt.do(c)
+			assert.Equal(t, tt.wantKeys, c.Keys())
+			assert.Equal(t, len(tt.wantKeys), c.Len())
+			assert.Equal(t, tt.wantEvicted, got)
+		})
+	}
+}
+
+func TestCache_Stats(t *testing.T) {
+	t.Parallel()
+
+	c := New[string, int](2)
+	c.Put("a", 1)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	_, _ = c.Peek("a")
+
+	assert.Equal(t, Stats{Hits: 1, Misses: 1}, c.Stats())
+}
+
+func TestCache_Resize(t *testing.T) {
+	t.Parallel()
+
+	var got []evicted
+	c := NewWithEvict(4, func(key string, val int) {
+		got = append(got, evicted{key, val})
+	})
+	for i, k := range []string{"a", "b", "c", "d"} {
+		c.Put(k, i)
+	}
+
+	assert.Equal(t, 0, c.Resize(5))
+	assert.Equal(t, 3, c.Resize(1))
+	assert.Equal(t, []evicted{{"a", 0}, {"b", 1}, {"c", 2}}, got)
+	assert.Equal(t, []string{"d"}, c.Keys())
+
+	k, v, ok := c.Oldest()
+	assert.Equal(t, "d", k)
+	assert.Equal(t, 3, v)
+	assert.True(t, ok)
+
+	assert.True(t, c.Put("e", 4))
+	assert.Panics(t, func() { c.Resize(0) })
+}
+
+func TestCache_Purge(t *testing.T) {
+	t.Parallel()
+
+	c := New[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Purge()
+
+	assert.Equal(t, 0, c.Len())
+	_, ok := c.Peek("a")
+	assert.False(t, ok)
+	_, _, ok = c.Oldest()
+	assert.False(t, ok)
+
+	c.Put("c", 3)
+	assert.Equal(t, []string{"c"}, c.Keys())
+}
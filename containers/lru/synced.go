@@ -0,0 +1,82 @@
+package lru
+
+import "sync"
+
+// Synced is a Cache guarded by a mutex so it can be shared between
+// goroutines. The eviction callback runs with the lock held and must not
+// call back into the cache.
+type Synced[K comparable, V any] struct {
+	mu    sync.Mutex
+	cache *Cache[K, V]
+}
+
+func NewSynced[K comparable, V any](capacity int) *Synced[K, V] {
+	return &Synced[K, V]{cache: New[K, V](capacity)}
+}
+
+func NewSyncedWithEvict[K comparable, V any](capacity int, onEvict func(key K, val V)) *Synced[K, V] {
+	return &Synced[K, V]{cache: NewWithEvict(capacity, onEvict)}
+}
+
+func (s *Synced[K, V]) Get(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Get(key)
+}
+
+func (s *Synced[K, V]) Peek(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Peek(key)
+}
+
+func (s *Synced[K, V]) Put(key K, val V) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Put(key, val)
+}
+
+func (s *Synced[K, V]) Remove(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Remove(key)
+}
+
+func (s *Synced[K, V]) Resize(capacity int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Resize(capacity)
+}
+
+func (s *Synced[K, V]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Len()
+}
+
+func (s *Synced[K, V]) Cap() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Cap()
+}
+
+func (s *Synced[K, V]) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Stats()
+}
+
+func (s *Synced[K, V]) Purge() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache.Purge()
+}
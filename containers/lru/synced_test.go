@@ -0,0 +1,54 @@
+package lru
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSynced_Concurrent(t *testing.T) {
+	const (
+		workers = 8
+		ops     = 1_000
+	)
+
+	t.Parallel()
+
+	var mu sync.Mutex
+	evictions := 0
+	c := NewSyncedWithEvict(16, func(string, int) {
+		mu.Lock()
+		evictions++
+		mu.Unlock()
+	})
+
+	keys := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < ops; i++ {
+				k := keys[(w+i)%len(keys)]
+				c.Put(k, i)
+				c.Get(k)
+				c.Peek(k)
+				if i%100 == 0 {
+					c.Remove(k)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	stats := c.Stats()
+	assert.Equal(t, uint64(workers*ops), stats.Hits+stats.Misses)
+	assert.LessOrEqual(t, c.Len(), c.Cap())
+	assert.Equal(t, 0, evictions)
+
+	assert.Equal(t, c.Len()-1, c.Resize(1))
+	c.Purge()
+	assert.Equal(t, 0, c.Len())
+}
@@ -0,0 +1,137 @@
+package orderedmap
+
+import (
+	"iter"
+
+	"github.com/ivdaria/go-containers/containers/dll"
+)
+
+type entry[K comparable, V any] struct {
+	key K
+	val V
+}
+
+// Map is a hash map that remembers insertion order. Every key is indexed
+// by its element in a doubly linked list, so lookups, deletes and moves
+// are O(1) and iteration follows the list.
+// The zero value is an empty map ready to use.
+type Map[K comparable, V any] struct {
+	index map[K]*dll.Element[entry[K, V]]
+	order dll.DLList[entry[K, V]]
+}
+
+func New[K comparable, V any]() *Map[K, V] {
+	return &Map[K, V]{index: make(map[K]*dll.Element[entry[K, V]])}
+}
+
+// Set stores val under key. A new key is appended at the end; an existing
+// key keeps its position.
+func (m *Map[K, V]) Set(key K, val V) {
+	if e, ok := m.index[key]; ok {
+		e.Value.val = val
+		return
+	}
+
+	if m.index == nil {
+		m.index = make(map[K]*dll.Element[entry[K, V]])
+	}
+	m.index[key] = m.order.PushBack(entry[K, V]{key: key, val: val})
+}
+
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	e, ok := m.index[key]
+	if !ok {
+		var vNil V
+		return vNil, false
+	}
+	return e.Value.val, true
+}
+
+// Delete removes key and reports whether it was present.
+func (m *Map[K, V]) Delete(key K) bool {
+	e, ok := m.index[key]
+	if !ok {
+		return false
+	}
+
+	m.order.Remove(e)
+	delete(m.index, key)
+
+	return true
+}
+
+// MoveToEnd makes key the newest entry and reports whether it was present.
+func (m *Map[K, V]) MoveToEnd(key K) bool {
+	e, ok := m.index[key]
+	if !ok {
+		return false
+	}
+
+	m.order.MoveToBack(e)
+	return true
+}
+
+// Oldest returns the first entry in order.
+func (m *Map[K, V]) Oldest() (K, V, bool) {
+	return unpack(m.order.Front())
+}
+
+// Newest returns the last entry in order.
+func (m *Map[K, V]) Newest() (K, V, bool) {
+	return unpack(m.order.Back())
+}
+
+func (m *Map[K, V]) Len() int {
+	return m.order.Size()
+}
+
+// All returns an iterator over key-value pairs from oldest to newest.
+// The current key may be deleted during iteration.
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := m.order.Front(); e != nil; {
+			// Delete clears the links of e, so step ahead first.
+			next := e.Next()
+			if !yield(e.Value.key, e.Value.val) {
+				return
+			}
+			e = next
+		}
+	}
+}
+
+// Backward returns an iterator over key-value pairs from newest to oldest.
+// The current key may be deleted during iteration.
+func (m *Map[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := m.order.Back(); e != nil; {
+			prev := e.Prev()
+			if !yield(e.Value.key, e.Value.val) {
+				return
+			}
+			e = prev
+		}
+	}
+}
+
+// Keys returns an iterator over the keys from oldest to newest.
+func (m *Map[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func unpack[K comparable, V any](e *dll.Element[entry[K, V]]) (K, V, bool) {
+	if e == nil {
+		var (
+			kNil K
+			vNil V
+		)
+		return kNil, vNil, false
+	}
+	return e.Value.key, e.Value.val, true
+}
@@ -0,0 +1,177 @@
+package orderedmap
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type pair struct {
+	key string
+	val int
+}
+
+func collect(m *Map[string, int], backward bool) []pair {
+	seq := m.All()
+	if backward {
+		seq = m.Backward()
+	}
+
+	var got []pair
+	for k, v := range seq {
+		got = append(got, pair{k, v})
+	}
+	return got
+}
+
+func TestMap_SetGet(t *testing.T) {
+	t.Parallel()
+
+	var m Map[string, int]
+	_, ok := m.Get("a")
+	assert.False(t, ok)
+
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("c", 3)
+	m.Set("b", 10)
+
+	v, ok := m.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
+	assert.Equal(t, 3, m.Len())
+	assert.Equal(t, []pair{{"b", 10}, {"a", 2}, {"c", 3}}, collect(&m, false))
+	assert.Equal(t, []pair{{"c", 3}, {"a", 2}, {"b", 10}}, collect(&m, true))
+	assert.Equal(t, []string{"b", "a", "c"}, slices.Collect(m.Keys()))
+}
+
+func TestMap_Delete(t *testing.T) {
+	type testCase struct {
+		name   string
+		key    string
+		wantOk bool
+		want   []pair
+	}
+	tests := []testCase{
+		{
+			name:   "missing key",
+			key:    "z",
+			wantOk: false,
+			want:   []pair{{"a", 1}, {"b", 2}, {"c", 3}},
+		},
+		{
+			name:   "oldest",
+			key:    "a",
+			wantOk: true,
+			want:   []pair{{"b", 2}, {"c", 3}},
+		},
+		{
+			name:   "middle",
+			key:    "b",
+			wantOk: true,
+			want:   []pair{{"a", 1}, {"c", 3}},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := New[string, int]()
+			m.Set("a", 1)
+			m.Set("b", 2)
+			m.Set("c", 3)
+
+			assert.Equal(t, tt.wantOk, m.Delete(tt.key))
+			assert.Equal(t, tt.want, collect(m, false))
+			_, ok := m.Get(tt.key)
+			assert.False(t, ok)
+
+			// A deleted key is appended again when it comes back.
+			m.Set(tt.key, 100)
+			k, v, _ := m.Newest()
+			assert.Equal(t, tt.key, k)
+			assert.Equal(t, 100, v)
+		})
+	}
+}
+
+func TestMap_DeleteWhileIterating(t *testing.T) {
+	type testCase struct {
+		name string
+		seq  func(m *Map[string, int]) iter.Seq[string]
+		want []string
+	}
+	tests := []testCase{
+		{
+			name: "keys",
+			seq:  func(m *Map[string, int]) iter.Seq[string] { return m.Keys() },
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "backward",
+			seq: func(m *Map[string, int]) iter.Seq[string] {
+				return func(yield func(string) bool) {
+					for k := range m.Backward() {
+						if !yield(k) {
+							return
+						}
+					}
+				}
+			},
+			want: []string{"c", "b", "a"},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var m Map[string, int]
+			m.Set("a", 1)
+			m.Set("b", 2)
+			m.Set("c", 3)
+
+			var got []string
+			for k := range tt.seq(&m) {
+				got = append(got, k)
+				m.Delete(k)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, 0, m.Len())
+		})
+	}
+}
+
+func TestMap_MoveToEnd(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+	assert.False(t, m.MoveToEnd("a"))
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+	assert.True(t, m.MoveToEnd("a"))
+	assert.Equal(t, []pair{{"b", 2}, {"c", 3}, {"a", 1}}, collect(m, false))
+
+	k, v, ok := m.Oldest()
+	assert.Equal(t, "b", k)
+	assert.Equal(t, 2, v)
+	assert.True(t, ok)
+}
+
+func TestMap_Empty(t *testing.T) {
+	t.Parallel()
+
+	m := New[string, int]()
+	_, _, ok := m.Oldest()
+	assert.False(t, ok)
+	_, _, ok = m.Newest()
+	assert.False(t, ok)
+	assert.Nil(t, collect(m, false))
+}
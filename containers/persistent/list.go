@@ -0,0 +1,178 @@
+package persistent
+
+import (
+	"iter"
+	"slices"
+)
+
+type cell[T any] struct {
+	next *cell[T]
+	val  T
+	size int
+}
+
+// PList is an immutable singly linked list. Every operation returns a new
+// list and leaves the receiver untouched, sharing as many cells with it as
+// possible, so old versions stay valid and can be read from any number of
+// goroutines without locking. The zero value is the empty list.
+type PList[T any] struct {
+	head *cell[T]
+}
+
+// Of returns a list holding vs in order.
+func Of[T any](vs ...T) PList[T] {
+	return FromSlice(vs)
+}
+
+// FromSlice returns a list holding the values of s in order.
+func FromSlice[T any](s []T) PList[T] {
+	var l PList[T]
+	for i := len(s) - 1; i >= 0; i-- {
+		l = l.Cons(s[i])
+	}
+	return l
+}
+
+// From returns a list holding the values produced by seq in order.
+func From[T any](seq iter.Seq[T]) PList[T] {
+	return FromSlice(slices.Collect(seq))
+}
+
+// Cons returns a list with v in front of l. It runs in O(1) and shares
+// all of l.
+func (l PList[T]) Cons(v T) PList[T] {
+	return PList[T]{head: &cell[T]{next: l.head, val: v, size: l.Size() + 1}}
+}
+
+// Head returns the first value of l, or false if l is empty.
+func (l PList[T]) Head() (T, bool) {
+	if l.head == nil {
+		var tNil T
+		return tNil, false
+	}
+	return l.head.val, true
+}
+
+// Tail returns l without its first value in O(1). The tail of the empty
+// list is the empty list.
+func (l PList[T]) Tail() PList[T] {
+	if l.head == nil {
+		return l
+	}
+	return PList[T]{head: l.head.next}
+}
+
+// Prepend returns a list with the values of other in front of l. The
+// cells of other are copied, while l is shared.
+func (l PList[T]) Prepend(other PList[T]) PList[T] {
+	vals := other.ToSlice()
+	for i := len(vals) - 1; i >= 0; i-- {
+		l = l.Cons(vals[i])
+	}
+	return l
+}
+
+// Reverse returns the values of l in reverse order. Every cell has to be
+// rebuilt, so nothing is shared.
+func (l PList[T]) Reverse() PList[T] {
+	var r PList[T]
+	for c := l.head; c != nil; c = c.next {
+		r = r.Cons(c.val)
+	}
+	return r
+}
+
+// Filter returns a list of the values for which keep returns true. The
+// longest suffix of l that keeps every value is shared rather than copied.
+func (l PList[T]) Filter(keep func(v T) bool) PList[T] {
+	var (
+		kept        []T
+		lastDropped *cell[T]
+		keptBefore  int
+	)
+	for c := l.head; c != nil; c = c.next {
+		if keep(c.val) {
+			kept = append(kept, c.val)
+		} else {
+			lastDropped, keptBefore = c, len(kept)
+		}
+	}
+
+	if lastDropped == nil {
+		return l
+	}
+
+	r := PList[T]{head: lastDropped.next}
+	for i := keptBefore - 1; i >= 0; i-- {
+		r = r.Cons(kept[i])
+	}
+	return r
+}
+
+// Map returns a list of f applied to every value of l. Since the values
+// change, the cells cannot be shared.
+func Map[T, U any](l PList[T], f func(v T) U) PList[U] {
+	vals := make([]U, 0, l.Size())
+	for c := l.head; c != nil; c = c.next {
+		vals = append(vals, f(c.val))
+	}
+	return FromSlice(vals)
+}
+
+func (l PList[T]) Size() int {
+	if l.head == nil {
+		return 0
+	}
+	return l.head.size
+}
+
+func (l PList[T]) IsEmpty() bool {
+	return l.head == nil
+}
+
+// At returns the value at idx in O(idx).
+func (l PList[T]) At(idx int) (T, error) {
+	if idx < 0 || idx >= l.Size() {
+		var tNil T
+		return tNil, ErrIndexIsOutOfSize
+	}
+
+	c := l.head
+	for i := 0; i < idx; i++ {
+		c = c.next
+	}
+	return c.val, nil
+}
+
+// All returns an iterator over index-value pairs from head to tail.
+func (l PList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		idx := 0
+		for c := l.head; c != nil; c = c.next {
+			if !yield(idx, c.val) {
+				return
+			}
+			idx++
+		}
+	}
+}
+
+// Values returns an iterator over the list values from head to tail.
+func (l PList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for c := l.head; c != nil; c = c.next {
+			if !yield(c.val) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice copies the list values into a new slice.
+func (l PList[T]) ToSlice() []T {
+	s := make([]T, 0, l.Size())
+	for c := l.head; c != nil; c = c.next {
+		s = append(s, c.val)
+	}
+	return s
+}
@@ -0,0 +1,232 @@
+package persistent
+
+import (
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers"
+)
+
+var _ containers.ReadOnlyList[int] = PList[int]{}
+
+// shares reports whether suffix is stored in the same cells as the end of l.
+func shares[T any](l, suffix PList[T]) bool {
+	c := l.head
+	for i := suffix.Size(); i < l.Size(); i++ {
+		c = c.next
+	}
+	return c == suffix.head
+}
+
+func TestPList_ConsHeadTail(t *testing.T) {
+	t.Parallel()
+
+	var empty PList[int]
+	assert.True(t, empty.IsEmpty())
+	assert.Zero(t, empty.Size())
+	_, ok := empty.Head()
+	assert.False(t, ok)
+	assert.True(t, empty.Tail().IsEmpty())
+
+	l := Of(2, 3)
+	l1 := l.Cons(1)
+	assert.Equal(t, []int{1, 2, 3}, l1.ToSlice())
+	assert.Equal(t, []int{2, 3}, l.ToSlice())
+	assert.Equal(t, 3, l1.Size())
+	assert.True(t, shares(l1, l))
+
+	v, ok := l1.Head()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	tail := l1.Tail()
+	assert.Equal(t, l, tail)
+	assert.Equal(t, 2, tail.Size())
+}
+
+func TestPList_At(t *testing.T) {
+	type testCase struct {
+		name    string
+		idx     int
+		want    int
+		wantErr error
+	}
+	tests := []testCase{
+		{name: "head", idx: 0, want: 1},
+		{name: "last", idx: 2, want: 3},
+		{name: "past the end", idx: 3, wantErr: ErrIndexIsOutOfSize},
+		{name: "negative", idx: -1, wantErr: ErrIndexIsOutOfSize},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := Of(1, 2, 3).At(tt.idx)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPList_Prepend(t *testing.T) {
+	type testCase struct {
+		name  string
+		l     PList[int]
+		other PList[int]
+		want  []int
+	}
+	tests := []testCase{
+		{
+			name:  "both non-empty",
+			l:     Of(3, 4),
+			other: Of(1, 2),
+			want:  []int{1, 2, 3, 4},
+		},
+		{
+			name:  "prepend empty",
+			l:     Of(3, 4),
+			other: PList[int]{},
+			want:  []int{3, 4},
+		},
+		{
+			name:  "prepend to empty",
+			l:     PList[int]{},
+			other: Of(1, 2),
+			want:  []int{1, 2},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			before := tt.other.ToSlice()
+
+			got := tt.l.Prepend(tt.other)
+			assert.Equal(t, tt.want, got.ToSlice())
+			assert.Equal(t, len(tt.want), got.Size())
+			assert.True(t, shares(got, tt.l))
+			assert.Equal(t, before, tt.other.ToSlice())
+		})
+	}
+}
+
+func TestPList_Reverse(t *testing.T) {
+	t.Parallel()
+
+	l := Of(1, 2, 3)
+	assert.Equal(t, []int{3, 2, 1}, l.Reverse().ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, l.ToSlice())
+	assert.True(t, PList[int]{}.Reverse().IsEmpty())
+}
+
+func TestPList_Filter(t *testing.T) {
+	type testCase struct {
+		name      string
+		l         PList[int]
+		want      []int
+		sharedLen int
+	}
+	even := func(v int) bool { return v%2 == 0 }
+	tests := []testCase{
+		{
+			name:      "keep all",
+			l:         Of(2, 4, 6),
+			want:      []int{2, 4, 6},
+			sharedLen: 3,
+		},
+		{
+			name:      "drop in the middle",
+			l:         Of(2, 3, 4, 6),
+			want:      []int{2, 4, 6},
+			sharedLen: 2,
+		},
+		{
+			name:      "drop the last",
+			l:         Of(2, 4, 5),
+			want:      []int{2, 4},
+			sharedLen: 0,
+		},
+		{
+			name:      "drop all",
+			l:         Of(1, 3),
+			want:      []int{},
+			sharedLen: 0,
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			before := tt.l.ToSlice()
+
+			got := tt.l.Filter(even)
+			assert.Equal(t, tt.want, got.ToSlice())
+			assert.Equal(t, len(tt.want), got.Size())
+			assert.Equal(t, before, tt.l.ToSlice())
+
+			suffix := tt.l
+			for suffix.Size() > tt.sharedLen {
+				suffix = suffix.Tail()
+			}
+			assert.True(t, shares(got, suffix))
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	got := Map(Of(1, 2, 3), strconv.Itoa)
+	assert.Equal(t, []string{"1", "2", "3"}, got.ToSlice())
+	assert.Equal(t, 3, got.Size())
+	assert.True(t, Map(PList[int]{}, strconv.Itoa).IsEmpty())
+}
+
+func TestPList_Iterators(t *testing.T) {
+	t.Parallel()
+
+	l := From(slices.Values([]int{5, 6, 7}))
+
+	var idxs, vals []int
+	for i, v := range l.All() {
+		if i == 2 {
+			break
+		}
+		idxs, vals = append(idxs, i), append(vals, v)
+	}
+	assert.Equal(t, []int{0, 1}, idxs)
+	assert.Equal(t, []int{5, 6}, vals)
+	assert.Equal(t, []int{5, 6, 7}, slices.Collect(l.Values()))
+}
+
+func TestPList_ConcurrentReaders(t *testing.T) {
+	t.Parallel()
+
+	base := FromSlice([]int{1, 2, 3, 4, 5})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 1_000; j++ {
+				l := base.Cons(i + 10).Filter(func(v int) bool { return v != 3 }).Tail()
+				assert.Equal(t, []int{1, 2, 4, 5}, l.ToSlice())
+				assert.Equal(t, 5, base.Reverse().Size())
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, base.ToSlice())
+}
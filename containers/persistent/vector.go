@@ -0,0 +1,307 @@
+package persistent
+
+import (
+	"errors"
+	"iter"
+	"slices"
+)
+
+var ErrIndexIsOutOfSize = errors.New("index is out of size")
+
+const (
+	levelBits = 5
+	width     = 1 << levelBits
+	levelMask = width - 1
+)
+
+// editToken marks the nodes a Builder may change in place. It must not be
+// zero-sized, or distinct tokens could share an address.
+type editToken struct {
+	_ byte
+}
+
+// vnode is an inner node holding children or a leaf holding values.
+type vnode[T any] struct {
+	children []*vnode[T]
+	values   []T
+	edit     *editToken
+}
+
+// Vector is an immutable indexable sequence modeled after Clojure's
+// persistent vector: a 32-way trie plus a tail buffer for the last values.
+// At, Set and Append touch O(log32 n) nodes, which is at most a handful
+// for any practical size, and every old version stays valid and safe for
+// concurrent reads. The zero value is the empty vector.
+type Vector[T any] struct {
+	root  *vnode[T]
+	tail  []T
+	shift uint
+	size  int
+}
+
+// VectorOf returns a vector holding vs in order.
+func VectorOf[T any](vs ...T) Vector[T] {
+	return VectorFrom(slices.Values(vs))
+}
+
+// VectorFrom returns a vector holding the values produced by seq in order.
+func VectorFrom[T any](seq iter.Seq[T]) Vector[T] {
+	b := Vector[T]{}.Transient()
+	for v := range seq {
+		b.Append(v)
+	}
+	return b.Persistent()
+}
+
+func (v Vector[T]) Size() int {
+	return v.size
+}
+
+func (v Vector[T]) IsEmpty() bool {
+	return v.size == 0
+}
+
+// tailOffset is the index of the first value kept in the tail.
+func (v Vector[T]) tailOffset() int {
+	return v.size - len(v.tail)
+}
+
+// leafFor returns the values of the leaf or tail holding idx.
+func (v Vector[T]) leafFor(idx int) []T {
+	if idx >= v.tailOffset() {
+		return v.tail
+	}
+
+	n := v.root
+	for level := v.shift; level > 0; level -= levelBits {
+		n = n.children[(idx>>level)&levelMask]
+	}
+	return n.values
+}
+
+func (v Vector[T]) At(idx int) (T, error) {
+	if idx < 0 || idx >= v.size {
+		var tNil T
+		return tNil, ErrIndexIsOutOfSize
+	}
+	return v.leafFor(idx)[idx&levelMask], nil
+}
+
+// Append returns a vector with x added at the end.
+func (v Vector[T]) Append(x T) Vector[T] {
+	if len(v.tail) < width {
+		tail := make([]T, len(v.tail), len(v.tail)+1)
+		copy(tail, v.tail)
+		v.tail = append(tail, x)
+		v.size++
+		return v
+	}
+
+	v.pushTail(nil)
+	v.tail = []T{x}
+	v.size++
+	return v
+}
+
+// Set returns a vector with the value at idx replaced by x.
+func (v Vector[T]) Set(idx int, x T) (Vector[T], error) {
+	if idx < 0 || idx >= v.size {
+		return v, ErrIndexIsOutOfSize
+	}
+
+	if idx >= v.tailOffset() {
+		v.tail = slices.Clone(v.tail)
+		v.tail[idx&levelMask] = x
+		return v, nil
+	}
+
+	v.root = assoc(v.root, v.shift, idx, x, nil)
+	return v, nil
+}
+
+// pushTail moves the full tail into the trie, adding a level on top when
+// the root is full.
+func (v *Vector[T]) pushTail(edit *editToken) {
+	leaf := &vnode[T]{values: v.tail, edit: edit}
+
+	switch {
+	case v.root == nil:
+		v.root, v.shift = leaf, 0
+	case v.size>>levelBits > 1<<v.shift:
+		v.root = &vnode[T]{
+			children: []*vnode[T]{v.root, newPath(v.shift, leaf, edit)},
+			edit:     edit,
+		}
+		v.shift += levelBits
+	default:
+		v.root = pushLeaf(v.root, v.shift, v.size-1, leaf, edit)
+	}
+}
+
+// editable returns n itself if it is owned by edit and a copy otherwise.
+// Persistent operations pass a nil edit and therefore always copy.
+func editable[T any](n *vnode[T], edit *editToken) *vnode[T] {
+	if edit != nil && n.edit == edit {
+		return n
+	}
+	return &vnode[T]{
+		children: slices.Clone(n.children),
+		values:   slices.Clone(n.values),
+		edit:     edit,
+	}
+}
+
+func newPath[T any](level uint, leaf *vnode[T], edit *editToken) *vnode[T] {
+	if level == 0 {
+		return leaf
+	}
+	return &vnode[T]{children: []*vnode[T]{newPath(level-levelBits, leaf, edit)}, edit: edit}
+}
+
+// pushLeaf places leaf below parent on the path to idx, the last index
+// covered by leaf.
+func pushLeaf[T any](parent *vnode[T], level uint, idx int, leaf *vnode[T], edit *editToken) *vnode[T] {
+	n := editable(parent, edit)
+	sub := (idx >> level) & levelMask
+
+	switch {
+	case level == levelBits:
+		n.children = append(n.children, leaf)
+	case sub < len(n.children):
+		n.children[sub] = pushLeaf(n.children[sub], level-levelBits, idx, leaf, edit)
+	default:
+		n.children = append(n.children, newPath(level-levelBits, leaf, edit))
+	}
+	return n
+}
+
+func assoc[T any](n *vnode[T], level uint, idx int, x T, edit *editToken) *vnode[T] {
+	n = editable(n, edit)
+	if level == 0 {
+		n.values[idx&levelMask] = x
+		return n
+	}
+
+	sub := (idx >> level) & levelMask
+	n.children[sub] = assoc(n.children[sub], level-levelBits, idx, x, edit)
+	return n
+}
+
+// All returns an iterator over index-value pairs in order.
+func (v Vector[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for start := 0; start < v.size; start += width {
+			for i, x := range v.leafFor(start) {
+				if !yield(start+i, x) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the vector values in order.
+func (v Vector[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, x := range v.All() {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice copies the vector values into a new slice.
+func (v Vector[T]) ToSlice() []T {
+	s := make([]T, 0, v.size)
+	for start := 0; start < v.size; start += width {
+		s = append(s, v.leafFor(start)...)
+	}
+	return s
+}
+
+// Builder is a transient version of a Vector for batches of updates. It
+// changes the nodes it has already copied in place instead of copying
+// them again on every call, while the vector it started from stays
+// untouched. A Builder is not safe for concurrent use and must not be
+// used after Persistent.
+type Builder[T any] struct {
+	vec     Vector[T]
+	edit    *editToken
+	ownTail bool
+}
+
+// Transient returns a Builder starting from v.
+func (v Vector[T]) Transient() *Builder[T] {
+	return &Builder[T]{vec: v, edit: new(editToken)}
+}
+
+func (b *Builder[T]) ensureEditable() {
+	if b.edit == nil {
+		panic("persistent: builder used after Persistent")
+	}
+}
+
+func (b *Builder[T]) Size() int {
+	return b.vec.Size()
+}
+
+func (b *Builder[T]) IsEmpty() bool {
+	return b.vec.IsEmpty()
+}
+
+func (b *Builder[T]) At(idx int) (T, error) {
+	return b.vec.At(idx)
+}
+
+func (b *Builder[T]) Append(x T) {
+	b.ensureEditable()
+
+	if len(b.vec.tail) == width {
+		// The pushed tail becomes an editable leaf, so it must not be
+		// shared with the vector the builder started from.
+		if !b.ownTail {
+			b.vec.tail = slices.Clone(b.vec.tail)
+		}
+		b.vec.pushTail(b.edit)
+		b.vec.tail, b.ownTail = nil, false
+	}
+
+	if !b.ownTail {
+		tail := make([]T, len(b.vec.tail), width)
+		copy(tail, b.vec.tail)
+		b.vec.tail, b.ownTail = tail, true
+	}
+
+	b.vec.tail = append(b.vec.tail, x)
+	b.vec.size++
+}
+
+func (b *Builder[T]) Set(idx int, x T) error {
+	b.ensureEditable()
+
+	if idx < 0 || idx >= b.vec.size {
+		return ErrIndexIsOutOfSize
+	}
+
+	if idx >= b.vec.tailOffset() {
+		if !b.ownTail {
+			b.vec.tail, b.ownTail = slices.Clone(b.vec.tail), true
+		}
+		b.vec.tail[idx&levelMask] = x
+		return nil
+	}
+
+	b.vec.root = assoc(b.vec.root, b.vec.shift, idx, x, b.edit)
+	return nil
+}
+
+// Persistent returns the built vector in O(1) and ends the use of b.
+func (b *Builder[T]) Persistent() Vector[T] {
+	b.ensureEditable()
+	b.edit = nil
+
+	v := b.vec
+	v.tail = slices.Clip(v.tail)
+	return v
+}
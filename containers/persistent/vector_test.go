@@ -0,0 +1,258 @@
+package persistent
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers"
+)
+
+var (
+	_ containers.ReadOnlyList[int] = Vector[int]{}
+	_ containers.ReadOnlyList[int] = (*Builder[int])(nil)
+)
+
+func seqInts(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func TestVector_Empty(t *testing.T) {
+	t.Parallel()
+
+	var v Vector[int]
+	assert.True(t, v.IsEmpty())
+	assert.Zero(t, v.Size())
+	assert.Empty(t, v.ToSlice())
+
+	_, err := v.At(0)
+	assert.ErrorIs(t, err, ErrIndexIsOutOfSize)
+	_, err = v.Set(0, 1)
+	assert.ErrorIs(t, err, ErrIndexIsOutOfSize)
+}
+
+func TestVector_AppendAt(t *testing.T) {
+	t.Parallel()
+
+	// The sizes cross the tail, the first leaf root and the second and
+	// third trie levels.
+	for _, n := range []int{1, 32, 33, 64, 65, 1_056, 1_057, 40_000} {
+		var v Vector[int]
+		for i := 0; i < n; i++ {
+			v = v.Append(i)
+		}
+
+		assert.Equal(t, n, v.Size())
+		assert.False(t, v.IsEmpty())
+		assert.Equal(t, seqInts(n), v.ToSlice())
+
+		for _, idx := range []int{0, n / 2, n - 1} {
+			got, err := v.At(idx)
+			assert.NoError(t, err)
+			assert.Equal(t, idx, got)
+		}
+
+		_, err := v.At(n)
+		assert.ErrorIs(t, err, ErrIndexIsOutOfSize)
+		_, err = v.At(-1)
+		assert.ErrorIs(t, err, ErrIndexIsOutOfSize)
+	}
+}
+
+func TestVector_OldVersions(t *testing.T) {
+	t.Parallel()
+
+	var v Vector[int]
+	versions := make([]Vector[int], 0, 2_000)
+	for i := 0; i < 2_000; i++ {
+		versions = append(versions, v)
+		v = v.Append(i)
+	}
+
+	for i, old := range versions {
+		if i%97 == 0 {
+			assert.Equal(t, seqInts(i), old.ToSlice())
+		}
+	}
+
+	// Appending to an old version forks it without touching newer ones.
+	fork := versions[40].Append(-1)
+	assert.Equal(t, append(seqInts(40), -1), fork.ToSlice())
+	got, _ := versions[41].At(40)
+	assert.Equal(t, 40, got)
+}
+
+func TestVector_Set(t *testing.T) {
+	type testCase struct {
+		name string
+		idx  int
+		err  error
+	}
+	tests := []testCase{
+		{name: "first", idx: 0},
+		{name: "in trie", idx: 500},
+		{name: "in tail", idx: 1_099},
+		{name: "past the end", idx: 1_100, err: ErrIndexIsOutOfSize},
+		{name: "negative", idx: -1, err: ErrIndexIsOutOfSize},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			v := VectorOf(seqInts(1_100)...)
+
+			got, err := v.Set(tt.idx, -1)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, seqInts(1_100), v.ToSlice())
+			if tt.err != nil {
+				return
+			}
+
+			want := seqInts(1_100)
+			want[tt.idx] = -1
+			assert.Equal(t, want, got.ToSlice())
+		})
+	}
+}
+
+func TestVector_Iterators(t *testing.T) {
+	t.Parallel()
+
+	v := VectorOf(seqInts(100)...)
+
+	var idxs []int
+	for i, x := range v.All() {
+		assert.Equal(t, i, x)
+		if i == 40 {
+			break
+		}
+		idxs = append(idxs, i)
+	}
+	assert.Equal(t, seqInts(40), idxs)
+	assert.Equal(t, seqInts(100), slices.Collect(v.Values()))
+}
+
+func TestBuilder(t *testing.T) {
+	t.Parallel()
+
+	base := VectorOf(seqInts(1_050)...)
+
+	b := base.Transient()
+	for i := 1_050; i < 2_000; i++ {
+		b.Append(i)
+	}
+	assert.NoError(t, b.Set(0, -1))
+	assert.NoError(t, b.Set(1_030, -2))
+	assert.NoError(t, b.Set(1_999, -3))
+	assert.ErrorIs(t, b.Set(2_000, 0), ErrIndexIsOutOfSize)
+
+	got, err := b.At(1_030)
+	assert.NoError(t, err)
+	assert.Equal(t, -2, got)
+	assert.Equal(t, 2_000, b.Size())
+
+	v := b.Persistent()
+	want := seqInts(2_000)
+	want[0], want[1_030], want[1_999] = -1, -2, -3
+	assert.Equal(t, want, v.ToSlice())
+	assert.Equal(t, seqInts(1_050), base.ToSlice())
+
+	assert.Panics(t, func() { b.Append(1) })
+	assert.Panics(t, func() { _ = b.Set(0, 1) })
+	assert.Panics(t, func() { b.Persistent() })
+
+	// A second builder must not write through nodes owned by the first.
+	b2 := v.Transient()
+	assert.NoError(t, b2.Set(0, 7))
+	b2.Append(2_000)
+	assert.Equal(t, want, v.ToSlice())
+	assert.Equal(t, 2_001, b2.Persistent().Size())
+}
+
+func TestVector_Random(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	var v Vector[int]
+	var ref []int
+	snaps := map[int][]int{}
+	vers := map[int]Vector[int]{}
+
+	for i := 0; i < 5_000; i++ {
+		if len(ref) > 0 && r.Intn(3) == 0 {
+			idx, x := r.Intn(len(ref)), r.Int()
+			v, _ = v.Set(idx, x)
+			ref = slices.Clone(ref)
+			ref[idx] = x
+		} else {
+			x := r.Int()
+			v = v.Append(x)
+			ref = append(slices.Clip(ref), x)
+		}
+
+		if i%250 == 0 {
+			snaps[i], vers[i] = ref, v
+		}
+	}
+
+	assert.Equal(t, ref, v.ToSlice())
+	for i, want := range snaps {
+		assert.Equal(t, want, vers[i].ToSlice())
+	}
+}
+
+func TestVector_ConcurrentReaders(t *testing.T) {
+	t.Parallel()
+
+	base := VectorOf(seqInts(3_000)...)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v, _ := base.Set(i*100, -1)
+			v = v.Append(i)
+
+			got, _ := v.At(i * 100)
+			assert.Equal(t, -1, got)
+			got, _ = base.At(i * 100)
+			assert.Equal(t, i*100, got)
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, seqInts(3_000), base.ToSlice())
+}
+
+func BenchmarkVector_Append(b *testing.B) {
+	const n = 10_000
+
+	b.Run("persistent", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			var v Vector[int]
+			for j := 0; j < n; j++ {
+				v = v.Append(j)
+			}
+		}
+	})
+
+	b.Run("transient", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tb := Vector[int]{}.Transient()
+			for j := 0; j < n; j++ {
+				tb.Append(j)
+			}
+			tb.Persistent()
+		}
+	})
+}
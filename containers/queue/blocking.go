@@ -0,0 +1,162 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ivdaria/go-containers/containers/sll"
+)
+
+var ErrClosed = errors.New("queue is closed")
+
+// BlockingQueue is a bounded FIFO queue for handing values between
+// goroutines. Put blocks while the queue is full and Take blocks while it
+// is empty. After Close, Put fails right away while Take keeps returning
+// the values that are still queued and fails only once they are drained.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	list     sll.SLList[T]
+	capacity int
+	closed   bool
+
+	// notEmpty and notFull are closed and replaced to wake up waiters.
+	notEmpty chan struct{}
+	notFull  chan struct{}
+}
+
+// NewBlockingQueue returns a queue holding at most capacity values.
+// It panics if capacity is not positive.
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	if capacity <= 0 {
+		panic("queue: capacity must be positive")
+	}
+
+	return &BlockingQueue[T]{
+		capacity: capacity,
+		notEmpty: make(chan struct{}),
+		notFull:  make(chan struct{}),
+	}
+}
+
+// Put adds v to the queue, waiting for free space until ctx is done.
+func (q *BlockingQueue[T]) Put(ctx context.Context, v T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for {
+		if q.closed {
+			return ErrClosed
+		}
+
+		if q.list.Size() < q.capacity {
+			q.list.Insert(v)
+			broadcast(&q.notEmpty)
+			return nil
+		}
+
+		if err := q.wait(ctx, q.notFull); err != nil {
+			return err
+		}
+	}
+}
+
+// Take removes the oldest value, waiting for one to arrive until ctx is
+// done. It returns ErrClosed once the queue is closed and empty.
+func (q *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for {
+		if v, err := q.list.PopFront(); err == nil {
+			broadcast(&q.notFull)
+			return v, nil
+		}
+
+		if q.closed {
+			var tNil T
+			return tNil, ErrClosed
+		}
+
+		if err := q.wait(ctx, q.notEmpty); err != nil {
+			var tNil T
+			return tNil, err
+		}
+	}
+}
+
+// Offer is Put with a timeout instead of a context. A zero timeout only
+// succeeds if there is free space right away.
+func (q *BlockingQueue[T]) Offer(v T, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return q.Put(ctx, v)
+}
+
+// Poll is Take with a timeout instead of a context. A zero timeout only
+// succeeds if a value is available right away.
+func (q *BlockingQueue[T]) Poll(timeout time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return q.Take(ctx)
+}
+
+// Close stops the queue from accepting values and wakes up every waiter.
+// Closing an already closed queue does nothing.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+
+	q.closed = true
+	broadcast(&q.notEmpty)
+	broadcast(&q.notFull)
+}
+
+// Drain removes and returns every queued value without waiting.
+func (q *BlockingQueue[T]) Drain() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	values := q.list.ToSlice()
+	q.list.Clear()
+	broadcast(&q.notFull)
+
+	return values
+}
+
+func (q *BlockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.list.Size()
+}
+
+func (q *BlockingQueue[T]) Cap() int {
+	return q.capacity
+}
+
+// wait releases the lock until ch is closed or ctx is done. It must be
+// called with q.mu held and returns with it held again.
+func (q *BlockingQueue[T]) wait(ctx context.Context, ch chan struct{}) error {
+	q.mu.Unlock()
+	defer q.mu.Lock()
+
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func broadcast(ch *chan struct{}) {
+	close(*ch)
+	*ch = make(chan struct{})
+}
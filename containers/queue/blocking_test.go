@@ -0,0 +1,208 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBlockingQueue(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() { NewBlockingQueue[int](0) })
+	assert.Equal(t, 3, NewBlockingQueue[int](3).Cap())
+}
+
+func TestBlockingQueue_OfferPoll(t *testing.T) {
+	type testCase struct {
+		name      string
+		capacity  int
+		offer     []int
+		wantErrs  []error
+		polls     int
+		want      []int
+		wantPollE []error
+	}
+	tests := []testCase{
+		{
+			name:      "poll on empty queue times out",
+			capacity:  1,
+			offer:     nil,
+			wantErrs:  nil,
+			polls:     1,
+			want:      []int{0},
+			wantPollE: []error{context.DeadlineExceeded},
+		},
+		{
+			name:      "offer on full queue times out",
+			capacity:  2,
+			offer:     []int{1, 2, 3},
+			wantErrs:  []error{nil, nil, context.DeadlineExceeded},
+			polls:     2,
+			want:      []int{1, 2},
+			wantPollE: []error{nil, nil},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			q := NewBlockingQueue[int](tt.capacity)
+
+			var errs []error
+			for _, v := range tt.offer {
+				errs = append(errs, q.Offer(v, time.Millisecond))
+			}
+			assert.Equal(t, tt.wantErrs, errs)
+
+			var got []int
+			var pollErrs []error
+			for i := 0; i < tt.polls; i++ {
+				v, err := q.Poll(time.Millisecond)
+				got = append(got, v)
+				pollErrs = append(pollErrs, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantPollE, pollErrs)
+		})
+	}
+}
+
+func TestBlockingQueue_PutBlocksUntilTake(t *testing.T) {
+	t.Parallel()
+
+	q := NewBlockingQueue[int](1)
+	ctx := context.Background()
+	assert.NoError(t, q.Put(ctx, 1))
+
+	put := make(chan error)
+	go func() {
+		put <- q.Put(ctx, 2)
+	}()
+
+	select {
+	case <-put:
+		t.Fatal("Put returned while the queue was full")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	v, err := q.Take(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+	assert.NoError(t, <-put)
+
+	v, err = q.Take(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v)
+}
+
+func TestBlockingQueue_Cancel(t *testing.T) {
+	t.Parallel()
+
+	q := NewBlockingQueue[int](1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	taken := make(chan error)
+	go func() {
+		_, err := q.Take(ctx)
+		taken <- err
+	}()
+
+	cancel()
+	assert.ErrorIs(t, <-taken, context.Canceled)
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestBlockingQueue_Close(t *testing.T) {
+	t.Parallel()
+
+	q := NewBlockingQueue[int](3)
+	ctx := context.Background()
+	assert.NoError(t, q.Put(ctx, 1))
+	assert.NoError(t, q.Put(ctx, 2))
+
+	q.Close()
+	q.Close()
+
+	assert.ErrorIs(t, q.Put(ctx, 3), ErrClosed)
+
+	v, err := q.Take(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, []int{2}, q.Drain())
+
+	_, err = q.Take(ctx)
+	assert.ErrorIs(t, err, ErrClosed)
+}
+
+func TestBlockingQueue_CloseWakesWaiters(t *testing.T) {
+	t.Parallel()
+
+	q := NewBlockingQueue[int](1)
+	ctx := context.Background()
+
+	taken := make(chan error)
+	go func() {
+		_, err := q.Take(ctx)
+		taken <- err
+	}()
+
+	time.Sleep(5 * time.Millisecond)
+	q.Close()
+	assert.ErrorIs(t, <-taken, ErrClosed)
+}
+
+func TestBlockingQueue_ProducersConsumers(t *testing.T) {
+	const (
+		producers = 4
+		consumers = 4
+		perProd   = 1_000
+	)
+
+	t.Parallel()
+
+	q := NewBlockingQueue[int](8)
+	ctx := context.Background()
+
+	var prodWG sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		prodWG.Add(1)
+		go func(p int) {
+			defer prodWG.Done()
+			for i := 0; i < perProd; i++ {
+				assert.NoError(t, q.Put(ctx, p*perProd+i))
+			}
+		}(p)
+	}
+
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	var consWG sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		consWG.Add(1)
+		go func() {
+			defer consWG.Done()
+			for {
+				v, err := q.Take(ctx)
+				if err != nil {
+					assert.ErrorIs(t, err, ErrClosed)
+					return
+				}
+				mu.Lock()
+				seen[v] = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	prodWG.Wait()
+	q.Close()
+	consWG.Wait()
+
+	assert.Len(t, seen, producers*perProd)
+}
@@ -0,0 +1,27 @@
+package queue
+
+import "github.com/ivdaria/go-containers/containers/sll"
+
+// Queue is a FIFO queue backed by a singly linked list.
+// The zero value is an empty queue ready to use.
+type Queue[T any] struct {
+	list sll.SLList[T]
+}
+
+func (q *Queue[T]) Push(v T) {
+	q.list.Insert(v)
+}
+
+func (q *Queue[T]) Pop() (T, bool) {
+	v, err := q.list.PopFront()
+	return v, err == nil
+}
+
+func (q *Queue[T]) Peek() (T, bool) {
+	v, err := q.list.At(0)
+	return v, err == nil
+}
+
+func (q *Queue[T]) Len() int {
+	return q.list.Size()
+}
@@ -0,0 +1,117 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers"
+)
+
+var (
+	_ containers.Queue[int] = (*Queue[int])(nil)
+	_ containers.Queue[int] = (*Slice[int])(nil)
+)
+
+var implementations = []struct {
+	name string
+	new  func() containers.Queue[int]
+}{
+	{
+		name: "linked",
+		new:  func() containers.Queue[int] { return &Queue[int]{} },
+	},
+	{
+		name: "slice",
+		new:  func() containers.Queue[int] { return NewSlice[int](0) },
+	},
+}
+
+func TestQueue(t *testing.T) {
+	type testCase struct {
+		name     string
+		push     []int
+		pops     int
+		want     []int
+		wantPeek int
+		wantOk   bool
+		wantLen  int
+	}
+	tests := []testCase{
+		{
+			name:     "empty queue",
+			push:     nil,
+			pops:     1,
+			want:     []int{0},
+			wantPeek: 0,
+			wantOk:   false,
+			wantLen:  0,
+		},
+		{
+			name:     "first in first out",
+			push:     []int{1, 2, 3},
+			pops:     2,
+			want:     []int{1, 2},
+			wantPeek: 3,
+			wantOk:   true,
+			wantLen:  1,
+		},
+		{
+			name:     "drain",
+			push:     []int{1, 2},
+			pops:     2,
+			want:     []int{1, 2},
+			wantPeek: 0,
+			wantOk:   false,
+			wantLen:  0,
+		},
+	}
+
+	t.Parallel()
+	for _, impl := range implementations {
+		for _, tt := range tests {
+			impl, tt := impl, tt
+			t.Run(impl.name+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+				q := impl.new()
+				for _, v := range tt.push {
+					q.Push(v)
+				}
+
+				var got []int
+				for i := 0; i < tt.pops; i++ {
+					v, _ := q.Pop()
+					got = append(got, v)
+				}
+				assert.Equal(t, tt.want, got)
+
+				peek, ok := q.Peek()
+				assert.Equal(t, tt.wantPeek, peek)
+				assert.Equal(t, tt.wantOk, ok)
+				assert.Equal(t, tt.wantLen, q.Len())
+			})
+		}
+	}
+}
+
+func TestQueue_Interleaved(t *testing.T) {
+	t.Parallel()
+	for _, impl := range implementations {
+		impl := impl
+		t.Run(impl.name, func(t *testing.T) {
+			t.Parallel()
+			q := impl.new()
+			next := 0
+			for i := 0; i < 1_000; i++ {
+				q.Push(i)
+				if i%3 != 0 {
+					v, ok := q.Pop()
+					assert.True(t, ok)
+					assert.Equal(t, next, v)
+					next++
+				}
+			}
+			assert.Equal(t, 1_000-next, q.Len())
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package queue
+
+// Slice is a FIFO queue backed by a slice. Popped slots at the front are
+// reclaimed once they make up half of the slice.
+// The zero value is an empty queue ready to use.
+type Slice[T any] struct {
+	items []T
+	head  int
+}
+
+// NewSlice returns a Slice with room for capacity values.
+func NewSlice[T any](capacity int) *Slice[T] {
+	return &Slice[T]{items: make([]T, 0, capacity)}
+}
+
+func (q *Slice[T]) Push(v T) {
+	q.items = append(q.items, v)
+}
+
+func (q *Slice[T]) Pop() (T, bool) {
+	var tNil T
+	if q.Len() == 0 {
+		return tNil, false
+	}
+
+	v := q.items[q.head]
+	q.items[q.head] = tNil
+	q.head++
+
+	if q.head == len(q.items) {
+		q.items, q.head = q.items[:0], 0
+	} else if q.head > len(q.items)/2 {
+		n := copy(q.items, q.items[q.head:])
+		clear(q.items[n:])
+		q.items, q.head = q.items[:n], 0
+	}
+
+	return v, true
+}
+
+func (q *Slice[T]) Peek() (T, bool) {
+	if q.Len() == 0 {
+		var tNil T
+		return tNil, false
+	}
+	return q.items[q.head], true
+}
+
+func (q *Slice[T]) Len() int {
+	return len(q.items) - q.head
+}
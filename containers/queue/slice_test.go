@@ -0,0 +1,28 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlice_Compaction(t *testing.T) {
+	t.Parallel()
+
+	q := NewSlice[int](0)
+	for i := 0; i < 10; i++ {
+		q.Push(i)
+	}
+	for i := 0; i < 6; i++ {
+		_, _ = q.Pop()
+	}
+
+	assert.Equal(t, 0, q.head)
+	assert.Equal(t, []int{6, 7, 8, 9}, q.items)
+
+	for i := 0; i < 4; i++ {
+		_, _ = q.Pop()
+	}
+	assert.Equal(t, 0, q.head)
+	assert.Empty(t, q.items)
+}
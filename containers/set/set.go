@@ -0,0 +1,160 @@
+package set
+
+import (
+	"iter"
+
+	"github.com/ivdaria/go-containers/containers"
+	"github.com/ivdaria/go-containers/containers/dll"
+	"github.com/ivdaria/go-containers/containers/sll"
+)
+
+// Set is an unordered collection of unique values backed by a map.
+// The zero value is an empty set ready to use.
+type Set[T comparable] struct {
+	m map[T]struct{}
+}
+
+func New[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{m: make(map[T]struct{}, len(items))}
+	for _, v := range items {
+		s.m[v] = struct{}{}
+	}
+	return s
+}
+
+// From builds a set from the values produced by seq.
+func From[T comparable](seq iter.Seq[T]) *Set[T] {
+	s := New[T]()
+	for v := range seq {
+		s.m[v] = struct{}{}
+	}
+	return s
+}
+
+// FromList builds a set from the values of any list, such as sll.SLList
+// or dll.DLList.
+func FromList[T comparable](l containers.List[T]) *Set[T] {
+	return From(l.Values())
+}
+
+// Add inserts v and reports whether it was not in the set yet.
+func (s *Set[T]) Add(v T) bool {
+	if s.Has(v) {
+		return false
+	}
+
+	if s.m == nil {
+		s.m = make(map[T]struct{})
+	}
+	s.m[v] = struct{}{}
+
+	return true
+}
+
+// Remove deletes v and reports whether it was in the set.
+func (s *Set[T]) Remove(v T) bool {
+	if !s.Has(v) {
+		return false
+	}
+
+	delete(s.m, v)
+	return true
+}
+
+func (s *Set[T]) Has(v T) bool {
+	_, ok := s.m[v]
+	return ok
+}
+
+func (s *Set[T]) Len() int {
+	return len(s.m)
+}
+
+// Values returns an iterator over the set values in no particular order.
+func (s *Set[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s.m {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Union returns a new set with the values that are in s or other.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	res := From(s.Values())
+	for v := range other.m {
+		res.m[v] = struct{}{}
+	}
+	return res
+}
+
+// Intersection returns a new set with the values that are in both s and
+// other.
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	small, big := s, other
+	if small.Len() > big.Len() {
+		small, big = big, small
+	}
+
+	res := New[T]()
+	for v := range small.m {
+		if big.Has(v) {
+			res.m[v] = struct{}{}
+		}
+	}
+	return res
+}
+
+// Difference returns a new set with the values of s that are not in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	res := New[T]()
+	for v := range s.m {
+		if !other.Has(v) {
+			res.m[v] = struct{}{}
+		}
+	}
+	return res
+}
+
+// SymmetricDifference returns a new set with the values that are in
+// exactly one of s and other.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	res := s.Difference(other)
+	for v := range other.m {
+		if !s.Has(v) {
+			res.m[v] = struct{}{}
+		}
+	}
+	return res
+}
+
+// IsSubset reports whether every value of s is also in other.
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+
+	for v := range s.m {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal reports whether s and other hold the same values.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.Len() == other.Len() && s.IsSubset(other)
+}
+
+// ToSLList copies the set values into a new singly linked list.
+func (s *Set[T]) ToSLList() *sll.SLList[T] {
+	return sll.From(s.Values())
+}
+
+// ToDLList copies the set values into a new doubly linked list.
+func (s *Set[T]) ToDLList() *dll.DLList[T] {
+	return dll.From(s.Values())
+}
@@ -0,0 +1,169 @@
+package set
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers/dll"
+	"github.com/ivdaria/go-containers/containers/sll"
+)
+
+func sorted(s *Set[int]) []int {
+	return slices.Sorted(s.Values())
+}
+
+func TestSet_AddRemove(t *testing.T) {
+	t.Parallel()
+
+	var s Set[int]
+	assert.False(t, s.Has(1))
+	assert.False(t, s.Remove(1))
+
+	assert.True(t, s.Add(1))
+	assert.False(t, s.Add(1))
+	assert.True(t, s.Add(2))
+	assert.Equal(t, 2, s.Len())
+	assert.True(t, s.Has(1))
+
+	assert.True(t, s.Remove(1))
+	assert.False(t, s.Has(1))
+	assert.Equal(t, []int{2}, sorted(&s))
+}
+
+func TestSet_Algebra(t *testing.T) {
+	type testCase struct {
+		name    string
+		a, b    []int
+		union   []int
+		inter   []int
+		diff    []int
+		symDiff []int
+	}
+	tests := []testCase{
+		{
+			name:    "both empty",
+			a:       nil,
+			b:       nil,
+			union:   nil,
+			inter:   nil,
+			diff:    nil,
+			symDiff: nil,
+		},
+		{
+			name:    "disjoint",
+			a:       []int{1, 2},
+			b:       []int{3},
+			union:   []int{1, 2, 3},
+			inter:   nil,
+			diff:    []int{1, 2},
+			symDiff: []int{1, 2, 3},
+		},
+		{
+			name:    "overlapping",
+			a:       []int{1, 2, 3, 4},
+			b:       []int{3, 4, 5},
+			union:   []int{1, 2, 3, 4, 5},
+			inter:   []int{3, 4},
+			diff:    []int{1, 2},
+			symDiff: []int{1, 2, 5},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			a, b := New(tt.a...), New(tt.b...)
+
+			assert.Equal(t, tt.union, sorted(a.Union(b)))
+			assert.Equal(t, tt.inter, sorted(a.Intersection(b)))
+			assert.Equal(t, tt.diff, sorted(a.Difference(b)))
+			assert.Equal(t, tt.symDiff, sorted(a.SymmetricDifference(b)))
+
+			// Operands are left untouched.
+			assert.Equal(t, len(tt.a), a.Len())
+			assert.Equal(t, len(tt.b), b.Len())
+		})
+	}
+}
+
+func TestSet_IsSubsetEqual(t *testing.T) {
+	type testCase struct {
+		name       string
+		a, b       []int
+		wantSubset bool
+		wantEqual  bool
+	}
+	tests := []testCase{
+		{
+			name:       "empty is subset",
+			a:          nil,
+			b:          []int{1},
+			wantSubset: true,
+			wantEqual:  false,
+		},
+		{
+			name:       "proper subset",
+			a:          []int{1, 2},
+			b:          []int{1, 2, 3},
+			wantSubset: true,
+			wantEqual:  false,
+		},
+		{
+			name:       "equal",
+			a:          []int{3, 2, 1},
+			b:          []int{1, 2, 3},
+			wantSubset: true,
+			wantEqual:  true,
+		},
+		{
+			name:       "same size different values",
+			a:          []int{1, 2},
+			b:          []int{1, 3},
+			wantSubset: false,
+			wantEqual:  false,
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			a, b := New(tt.a...), New(tt.b...)
+			assert.Equal(t, tt.wantSubset, a.IsSubset(b))
+			assert.Equal(t, tt.wantEqual, a.Equal(b))
+		})
+	}
+}
+
+func TestSet_Lists(t *testing.T) {
+	t.Parallel()
+
+	s := FromList[int](sll.FromSlice([]int{3, 1, 3, 2}))
+	assert.Equal(t, []int{1, 2, 3}, sorted(s))
+	assert.True(t, s.Equal(FromList[int](dll.FromSlice([]int{1, 2, 3, 1}))))
+
+	sl := s.ToSLList()
+	sll.Sort(sl)
+	assert.Equal(t, []int{1, 2, 3}, sl.ToSlice())
+
+	dl := s.ToDLList()
+	dll.Sort(dl)
+	assert.Equal(t, []int{1, 2, 3}, dl.ToSlice())
+}
+
+func TestSet_ValuesBreak(t *testing.T) {
+	t.Parallel()
+
+	s := New(1, 2, 3)
+	count := 0
+	for range s.Values() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+}
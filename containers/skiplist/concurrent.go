@@ -0,0 +1,161 @@
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+)
+
+type cnode[K, V any] struct {
+	key  K
+	val  atomic.Pointer[V]
+	next []atomic.Pointer[cnode[K, V]]
+}
+
+// Concurrent is a skip list whose readers never block. Writers take a
+// mutex, link new nodes bottom-up and unlink removed ones top-down, so a
+// reader always finds a consistent level 0 chain; it may or may not see
+// writes that race with it. Rank based access is left to SkipList since
+// spans cannot be kept consistent for lock-free readers.
+type Concurrent[K, V any] struct {
+	mu     sync.Mutex
+	head   *cnode[K, V]
+	level  atomic.Int32
+	length atomic.Int64
+	cmp    func(a, b K) int
+	rng    *rand.Rand
+}
+
+// NewConcurrent returns an empty concurrent skip list ordered by compare. See
+// New for the meaning of rng.
+func NewConcurrent[K, V any](compare func(a, b K) int, rng *rand.Rand) *Concurrent[K, V] {
+	if rng == nil {
+		rng = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+
+	s := &Concurrent[K, V]{
+		head: &cnode[K, V]{next: make([]atomic.Pointer[cnode[K, V]], maxLevel)},
+		cmp:  compare,
+		rng:  rng,
+	}
+	s.level.Store(1)
+
+	return s
+}
+
+// NewConcurrentOrdered returns an empty concurrent skip list ordered by
+// the natural order of K.
+func NewConcurrentOrdered[K cmp.Ordered, V any](rng *rand.Rand) *Concurrent[K, V] {
+	return NewConcurrent[K, V](cmp.Compare[K], rng)
+}
+
+func (s *Concurrent[K, V]) Len() int {
+	return int(s.length.Load())
+}
+
+// findPrev fills update with the last node before key on every level and
+// returns the node that follows it on level 0.
+func (s *Concurrent[K, V]) findPrev(key K, update *[maxLevel]*cnode[K, V]) *cnode[K, V] {
+	x := s.head
+	for i := int(s.level.Load()) - 1; i >= 0; i-- {
+		for next := x.next[i].Load(); next != nil && s.cmp(next.key, key) < 0; next = x.next[i].Load() {
+			x = next
+		}
+		if update != nil {
+			update[i] = x
+		}
+	}
+	return x.next[0].Load()
+}
+
+// Insert stores val under key and reports whether the key is new.
+func (s *Concurrent[K, V]) Insert(key K, val V) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [maxLevel]*cnode[K, V]
+	if x := s.findPrev(key, &update); x != nil && s.cmp(x.key, key) == 0 {
+		x.val.Store(&val)
+		return false
+	}
+
+	lvl := randomLevel(s.rng)
+	if cur := int(s.level.Load()); lvl > cur {
+		for i := cur; i < lvl; i++ {
+			update[i] = s.head
+		}
+		s.level.Store(int32(lvl))
+	}
+
+	n := &cnode[K, V]{key: key, next: make([]atomic.Pointer[cnode[K, V]], lvl)}
+	n.val.Store(&val)
+	for i := 0; i < lvl; i++ {
+		n.next[i].Store(update[i].next[i].Load())
+	}
+	// Publish from the bottom so a reader that finds n on a higher level
+	// can always continue from it on the lower ones.
+	for i := 0; i < lvl; i++ {
+		update[i].next[i].Store(n)
+	}
+
+	s.length.Add(1)
+	return true
+}
+
+// Delete removes key and reports whether it was present.
+func (s *Concurrent[K, V]) Delete(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [maxLevel]*cnode[K, V]
+	x := s.findPrev(key, &update)
+	if x == nil || s.cmp(x.key, key) != 0 {
+		return false
+	}
+
+	// Unlink from the top; x keeps its own links so readers standing on
+	// it can still move forward.
+	for i := len(x.next) - 1; i >= 0; i-- {
+		if update[i].next[i].Load() == x {
+			update[i].next[i].Store(x.next[i].Load())
+		}
+	}
+
+	s.length.Add(-1)
+	return true
+}
+
+// Search returns the value stored under key without taking any lock.
+func (s *Concurrent[K, V]) Search(key K) (V, bool) {
+	if x := s.findPrev(key, nil); x != nil && s.cmp(x.key, key) == 0 {
+		return *x.val.Load(), true
+	}
+
+	var vNil V
+	return vNil, false
+}
+
+// All returns an iterator over all entries in ascending key order.
+func (s *Concurrent[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for x := s.head.next[0].Load(); x != nil; x = x.next[0].Load() {
+			if !yield(x.key, *x.val.Load()) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over the entries with lo <= key < hi in
+// ascending key order.
+func (s *Concurrent[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for x := s.findPrev(lo, nil); x != nil && s.cmp(x.key, hi) < 0; x = x.next[0].Load() {
+			if !yield(x.key, *x.val.Load()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package skiplist
+
+import (
+	"math/rand/v2"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrent_Sequential(t *testing.T) {
+	t.Parallel()
+
+	s := NewConcurrentOrdered[int, string](rand.New(rand.NewPCG(1, 1)))
+	assert.True(t, s.Insert(2, "b"))
+	assert.True(t, s.Insert(1, "a"))
+	assert.True(t, s.Insert(3, "c"))
+	assert.False(t, s.Insert(1, "aa"))
+
+	v, ok := s.Search(1)
+	assert.True(t, ok)
+	assert.Equal(t, "aa", v)
+
+	assert.True(t, s.Delete(2))
+	assert.False(t, s.Delete(2))
+	_, ok = s.Search(2)
+	assert.False(t, ok)
+	assert.Equal(t, 2, s.Len())
+
+	var got []int
+	for k := range s.All() {
+		got = append(got, k)
+	}
+	assert.Equal(t, []int{1, 3}, got)
+
+	got = nil
+	for k := range s.Range(2, 4) {
+		got = append(got, k)
+	}
+	assert.Equal(t, []int{3}, got)
+}
+
+func TestConcurrent_Stress(t *testing.T) {
+	const (
+		writers = 4
+		readers = 8
+		ops     = 2_000
+		keys    = 256
+	)
+
+	t.Parallel()
+
+	s := NewConcurrentOrdered[int, int](rand.New(rand.NewPCG(2, 2)))
+
+	// Even keys are never deleted, so readers must always find them.
+	for k := 0; k < keys; k += 2 {
+		s.Insert(k, k)
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < ops; i++ {
+				k := (w*ops+i)%keys | 1
+				if i%2 == 0 {
+					s.Insert(k, k)
+				} else {
+					s.Delete(k)
+				}
+			}
+		}(w)
+	}
+
+	for r := 0; r < readers; r++ {
+		wg.Add(1)
+		go func(r int) {
+			defer wg.Done()
+			for i := 0; i < ops; i++ {
+				k := (r*ops + i) % keys &^ 1
+				v, ok := s.Search(k)
+				assert.True(t, ok)
+				assert.Equal(t, k, v)
+
+				prev := -1
+				for k, v := range s.Range(k, k+16) {
+					assert.Less(t, prev, k)
+					assert.Equal(t, k, v)
+					prev = k
+				}
+			}
+		}(r)
+	}
+
+	wg.Wait()
+
+	prev := -1
+	n := 0
+	for k := range s.All() {
+		assert.Less(t, prev, k)
+		prev = k
+		n++
+	}
+	assert.Equal(t, s.Len(), n)
+}
@@ -0,0 +1,247 @@
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+)
+
+const (
+	maxLevel = 32
+	// Each level holds roughly a quarter of the nodes of the level below.
+	levelFactor = 4
+)
+
+type node[K, V any] struct {
+	key  K
+	val  V
+	next []*node[K, V]
+	// span[i] is the number of level 0 steps covered by next[i], which
+	// lets At and Rank skip whole runs of nodes.
+	span []int
+}
+
+// SkipList is a sorted map made of towers of singly linked nodes. Every
+// link also records how many nodes it skips, so lookups by rank are
+// O(log n) on average. It is not safe for concurrent use; see Concurrent.
+type SkipList[K, V any] struct {
+	head   *node[K, V]
+	level  int
+	length int
+	cmp    func(a, b K) int
+	rng    *rand.Rand
+}
+
+// New returns an empty skip list ordered by compare. Tower heights are drawn
+// from rng, so passing a seeded source makes the layout reproducible;
+// a nil rng uses a randomly seeded one.
+func New[K, V any](compare func(a, b K) int, rng *rand.Rand) *SkipList[K, V] {
+	if rng == nil {
+		rng = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+
+	return &SkipList[K, V]{
+		head: &node[K, V]{
+			next: make([]*node[K, V], maxLevel),
+			span: make([]int, maxLevel),
+		},
+		level: 1,
+		cmp:   compare,
+		rng:   rng,
+	}
+}
+
+// NewOrdered returns an empty skip list ordered by the natural order of K.
+func NewOrdered[K cmp.Ordered, V any](rng *rand.Rand) *SkipList[K, V] {
+	return New[K, V](cmp.Compare[K], rng)
+}
+
+func randomLevel(rng *rand.Rand) int {
+	lvl := 1
+	for lvl < maxLevel && rng.IntN(levelFactor) == 0 {
+		lvl++
+	}
+	return lvl
+}
+
+func (s *SkipList[K, V]) Len() int {
+	return s.length
+}
+
+// Insert stores val under key and reports whether the key is new.
+func (s *SkipList[K, V]) Insert(key K, val V) bool {
+	var (
+		update [maxLevel]*node[K, V]
+		rank   [maxLevel]int
+	)
+
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && s.cmp(x.next[i].key, key) < 0 {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+
+	if next := x.next[0]; next != nil && s.cmp(next.key, key) == 0 {
+		next.val = val
+		return false
+	}
+
+	lvl := randomLevel(s.rng)
+	if lvl > s.level {
+		for i := s.level; i < lvl; i++ {
+			rank[i] = 0
+			update[i] = s.head
+			s.head.span[i] = s.length
+		}
+		s.level = lvl
+	}
+
+	n := &node[K, V]{
+		key:  key,
+		val:  val,
+		next: make([]*node[K, V], lvl),
+		span: make([]int, lvl),
+	}
+	for i := 0; i < lvl; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	for i := lvl; i < s.level; i++ {
+		update[i].span[i]++
+	}
+
+	s.length++
+	return true
+}
+
+// Delete removes key and reports whether it was present.
+func (s *SkipList[K, V]) Delete(key K) bool {
+	var update [maxLevel]*node[K, V]
+
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.cmp(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+
+	x = x.next[0]
+	if x == nil || s.cmp(x.key, key) != 0 {
+		return false
+	}
+
+	for i := 0; i < s.level; i++ {
+		if update[i].next[i] == x {
+			update[i].span[i] += x.span[i] - 1
+			update[i].next[i] = x.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+
+	s.length--
+	return true
+}
+
+// Search returns the value stored under key.
+func (s *SkipList[K, V]) Search(key K) (V, bool) {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.cmp(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+	}
+
+	if x = x.next[0]; x != nil && s.cmp(x.key, key) == 0 {
+		return x.val, true
+	}
+
+	var vNil V
+	return vNil, false
+}
+
+// At returns the entry with the given zero-based rank in key order.
+func (s *SkipList[K, V]) At(rank int) (K, V, bool) {
+	if rank < 0 || rank >= s.length {
+		var (
+			kNil K
+			vNil V
+		)
+		return kNil, vNil, false
+	}
+
+	// The head sits at position 0, so the wanted node is at rank+1.
+	traversed, target := 0, rank+1
+
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && traversed+x.span[i] <= target {
+			traversed += x.span[i]
+			x = x.next[i]
+		}
+		if traversed == target {
+			break
+		}
+	}
+
+	return x.key, x.val, true
+}
+
+// Rank returns the number of keys strictly less than key.
+func (s *SkipList[K, V]) Rank(key K) int {
+	rank := 0
+
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.cmp(x.next[i].key, key) < 0 {
+			rank += x.span[i]
+			x = x.next[i]
+		}
+	}
+
+	return rank
+}
+
+// All returns an iterator over all entries in ascending key order.
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for x := s.head.next[0]; x != nil; x = x.next[0] {
+			if !yield(x.key, x.val) {
+				return
+			}
+		}
+	}
+}
+
+// Range returns an iterator over the entries with lo <= key < hi in
+// ascending key order.
+func (s *SkipList[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		x := s.head
+		for i := s.level - 1; i >= 0; i-- {
+			for x.next[i] != nil && s.cmp(x.next[i].key, lo) < 0 {
+				x = x.next[i]
+			}
+		}
+
+		for x = x.next[0]; x != nil && s.cmp(x.key, hi) < 0; x = x.next[0] {
+			if !yield(x.key, x.val) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package skiplist
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSeeded(seed uint64) *SkipList[int, string] {
+	return NewOrdered[int, string](rand.New(rand.NewPCG(seed, seed)))
+}
+
+func keys(s *SkipList[int, string]) []int {
+	var got []int
+	for k := range s.All() {
+		got = append(got, k)
+	}
+	return got
+}
+
+func TestSkipList_InsertSearchDelete(t *testing.T) {
+	t.Parallel()
+
+	s := newSeeded(1)
+	_, ok := s.Search(1)
+	assert.False(t, ok)
+
+	assert.True(t, s.Insert(2, "b"))
+	assert.True(t, s.Insert(1, "a"))
+	assert.True(t, s.Insert(3, "c"))
+	assert.False(t, s.Insert(1, "aa"))
+
+	v, ok := s.Search(1)
+	assert.True(t, ok)
+	assert.Equal(t, "aa", v)
+	assert.Equal(t, 3, s.Len())
+	assert.Equal(t, []int{1, 2, 3}, keys(s))
+
+	assert.True(t, s.Delete(2))
+	assert.False(t, s.Delete(2))
+	_, ok = s.Search(2)
+	assert.False(t, ok)
+	assert.Equal(t, []int{1, 3}, keys(s))
+}
+
+func TestSkipList_Deterministic(t *testing.T) {
+	t.Parallel()
+
+	levels := func(seed uint64) []int {
+		s := newSeeded(seed)
+		for i := 0; i < 100; i++ {
+			s.Insert(i, "")
+		}
+
+		var got []int
+		for x := s.head.next[0]; x != nil; x = x.next[0] {
+			got = append(got, len(x.next))
+		}
+		return got
+	}
+
+	assert.Equal(t, levels(7), levels(7))
+	assert.NotEqual(t, levels(7), levels(8))
+}
+
+func TestSkipList_Range(t *testing.T) {
+	type testCase struct {
+		name   string
+		lo, hi int
+		want   []int
+	}
+	tests := []testCase{
+		{
+			name: "empty range",
+			lo:   4,
+			hi:   4,
+			want: nil,
+		},
+		{
+			name: "hi is exclusive",
+			lo:   3,
+			hi:   7,
+			want: []int{4, 6},
+		},
+		{
+			name: "bounds outside the keys",
+			lo:   -1,
+			hi:   100,
+			want: []int{0, 2, 4, 6, 8},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := newSeeded(3)
+			for _, k := range []int{8, 0, 6, 2, 4} {
+				s.Insert(k, "")
+			}
+
+			var got []int
+			for k := range s.Range(tt.lo, tt.hi) {
+				got = append(got, k)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSkipList_RankAt(t *testing.T) {
+	t.Parallel()
+
+	s := newSeeded(4)
+	for _, k := range []int{50, 10, 40, 20, 30} {
+		s.Insert(k, "")
+	}
+
+	for i, k := range []int{10, 20, 30, 40, 50} {
+		assert.Equal(t, i, s.Rank(k))
+
+		got, _, ok := s.At(i)
+		assert.True(t, ok)
+		assert.Equal(t, k, got)
+	}
+
+	assert.Equal(t, 2, s.Rank(25))
+	_, _, ok := s.At(5)
+	assert.False(t, ok)
+	_, _, ok = s.At(-1)
+	assert.False(t, ok)
+}
+
+func TestSkipList_Random(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewPCG(5, 5))
+	s := newSeeded(5)
+	ref := make(map[int]bool)
+
+	for i := 0; i < 5_000; i++ {
+		k := r.IntN(400)
+		if r.IntN(3) == 0 {
+			assert.Equal(t, ref[k], s.Delete(k))
+			delete(ref, k)
+		} else {
+			assert.Equal(t, !ref[k], s.Insert(k, ""))
+			ref[k] = true
+		}
+	}
+
+	want := make([]int, 0, len(ref))
+	for k := range ref {
+		want = append(want, k)
+	}
+	slices.Sort(want)
+
+	assert.Equal(t, want, keys(s))
+	assert.Equal(t, len(want), s.Len())
+	for i, k := range want {
+		got, _, _ := s.At(i)
+		assert.Equal(t, k, got)
+		assert.Equal(t, i, s.Rank(k))
+	}
+}
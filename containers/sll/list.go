@@ -3,6 +3,8 @@ package sll
 import (
 	"errors"
 	"fmt"
+	"iter"
+	"slices"
 )
 
 var ErrIndexIsOutOfSize = errors.New("index is out of size")
@@ -14,9 +16,24 @@ type node[T any] struct {
 
 type SLList[T any] struct {
 	head *node[T]
+	tail *node[T]
 	size int
 }
 
+// FromSlice builds a list holding the values of s in order.
+func FromSlice[T any](s []T) *SLList[T] {
+	return From(slices.Values(s))
+}
+
+// From builds a list from the values produced by seq.
+func From[T any](seq iter.Seq[T]) *SLList[T] {
+	l := &SLList[T]{}
+	for v := range seq {
+		l.Insert(v)
+	}
+	return l
+}
+
 func (l *SLList[T]) getNodeByIdx(idx int) *node[T] {
 	current := l.head
 
@@ -30,29 +47,70 @@ func (l *SLList[T]) getNodeByIdx(idx int) *node[T] {
 func (l *SLList[T]) Insert(elem T) {
 	node := &node[T]{val: elem}
 
+	l.size++
+
 	if l.head == nil {
 		l.head = node
-		l.size = 1
+		l.tail = node
 		return
 	}
 
-	current := l.head
+	l.tail.next = node
+	l.tail = node
+}
 
-	for current.next != nil {
-		current = current.next
+// Traverse calls f for every value from head to tail.
+//
+// Deprecated: use Each or All, which are typed and can stop early.
+func (l *SLList[T]) Traverse(f func(v any)) {
+	l.Each(func(_ int, v T) bool {
+		f(v)
+		return true
+	})
+}
+
+// Each calls f for every element from head to tail until f returns false.
+func (l *SLList[T]) Each(f func(idx int, v T) bool) {
+	idx := 0
+	for current := l.head; current != nil; current = current.next {
+		if !f(idx, current.val) {
+			return
+		}
+		idx++
 	}
+}
 
-	l.size++
-	current.next = node
+// All returns an iterator over index-value pairs from head to tail.
+func (l *SLList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		idx := 0
+		for current := l.head; current != nil; current = current.next {
+			if !yield(idx, current.val) {
+				return
+			}
+			idx++
+		}
+	}
 }
 
-func (l *SLList[T]) Traverse(f func(v any)) {
-	current := l.head
+// Values returns an iterator over the list values from head to tail.
+func (l *SLList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := l.head; current != nil; current = current.next {
+			if !yield(current.val) {
+				return
+			}
+		}
+	}
+}
 
-	for current != nil {
-		f(current.val)
-		current = current.next
+// ToSlice copies the list values into a new slice.
+func (l *SLList[T]) ToSlice() []T {
+	s := make([]T, 0, l.size)
+	for current := l.head; current != nil; current = current.next {
+		s = append(s, current.val)
 	}
+	return s
 }
 
 func (l *SLList[T]) IsEmpty() bool {
@@ -86,14 +144,19 @@ func (l *SLList[T]) DeleteAt(idx int) error {
 	l.size--
 
 	if idx == 0 {
-		l.head.val = l.head.next.val
-		l.head.next = l.head.next.next
+		l.head = l.head.next
+		if l.head == nil {
+			l.tail = nil
+		}
 		return nil
 	}
 
 	current := l.getNodeByIdx(idx - 1)
 
 	current.next = current.next.next
+	if current.next == nil {
+		l.tail = current
+	}
 	return nil
 }
 
@@ -101,9 +164,34 @@ func (l *SLList[T]) InsertFront(t T) {
 	node := &node[T]{val: t}
 	node.next = l.head
 	l.head = node
+	if l.tail == nil {
+		l.tail = node
+	}
 	l.size++
 }
 
+// PopFront removes the head of the list and returns its value.
+func (l *SLList[T]) PopFront() (T, error) {
+	if l.IsEmpty() {
+		var tNil T
+		return tNil, ErrIndexIsOutOfSize
+	}
+
+	val := l.head.val
+	_ = l.DeleteAt(0)
+
+	return val, nil
+}
+
+// PeekBack returns the value of the last node without removing it.
+func (l *SLList[T]) PeekBack() (T, error) {
+	if l.tail == nil {
+		var tNil T
+		return tNil, ErrIndexIsOutOfSize
+	}
+	return l.tail.val, nil
+}
+
 // InsertAt Вставка элемента на позицию idx
 func (l *SLList[T]) InsertAt(idx int, t T) error {
 	if idx < 0 {
@@ -130,41 +218,3 @@ func (l *SLList[T]) InsertAt(idx int, t T) error {
 	l.size++
 	return nil
 }
-
-//func (l *SLList[T]) sort() {
-//	if l.IsEmpty() {
-//		fmt.Println("the list is empty")
-//		return
-//	}
-//
-//	x := l.Size()
-//	data := make([]T, 0, x)
-//
-//	current := l.head
-//	count := 0
-//
-//	for current != nil {
-//		data[count] = current.val
-//		count++
-//		current = current.next
-//	}
-//
-//	for i := 0; i < (x - 1); i++ {
-//		for j := (x - 1); j > i; j-- {
-//			if data[j-1] > data[j] {
-//				temp := data[j-1]
-//				data[j-1] = data[j]
-//				data[j] = temp
-//			}
-//		}
-//	}
-//
-//	current = l.head
-//	count = 0
-//
-//	for current != nil {
-//		current.val = data[count]
-//		count++
-//		current = current.next
-//	}
-//}
@@ -1,11 +1,24 @@
 package sll
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers"
 )
 
+var _ containers.List[int] = (*SLList[int])(nil)
+
+// withTail sets the tail of a list built from a literal.
+func withTail[T any](l SLList[T]) SLList[T] {
+	for current := l.head; current != nil; current = current.next {
+		l.tail = current
+	}
+	return l
+}
+
 func TestList_Insert(t *testing.T) {
 	type args[T any] struct {
 		elem T
@@ -30,13 +43,13 @@ func TestList_Insert(t *testing.T) {
 		},
 		{
 			name: "insert to list with one element",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: nil,
 					val:  11,
 				},
 				size: 1,
-			},
+			}),
 			args: args[int]{
 				elem: 12,
 			},
@@ -45,7 +58,7 @@ func TestList_Insert(t *testing.T) {
 		},
 		{
 			name: "insert to list with two elements",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: &node[int]{
 						next: nil,
@@ -54,7 +67,7 @@ func TestList_Insert(t *testing.T) {
 					val: 11,
 				},
 				size: 2,
-			},
+			}),
 			args: args[int]{
 				elem: 12,
 			},
@@ -92,18 +105,18 @@ func TestList_IsEmpty(t *testing.T) {
 		},
 		{
 			name: "check list with head",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: nil,
 					val:  11,
 				},
 				size: 1,
-			},
+			}),
 			want: false,
 		},
 		{
 			name: "check list with body",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: &node[int]{
 						next: nil,
@@ -112,7 +125,7 @@ func TestList_IsEmpty(t *testing.T) {
 					val: 11,
 				},
 				size: 2,
-			},
+			}),
 			want: false,
 		},
 	}
@@ -150,7 +163,7 @@ func TestList_At(t *testing.T) {
 		},
 		{
 			name: "non-empty list",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: &node[int]{
 						next: nil,
@@ -159,7 +172,7 @@ func TestList_At(t *testing.T) {
 					val: 11,
 				},
 				size: 2,
-			},
+			}),
 			args: args{
 				idx: 1,
 			},
@@ -168,7 +181,7 @@ func TestList_At(t *testing.T) {
 		},
 		{
 			name: "non-empty list negative index",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: &node[int]{
 						next: nil,
@@ -177,7 +190,7 @@ func TestList_At(t *testing.T) {
 					val: 11,
 				},
 				size: 2,
-			},
+			}),
 			args: args{
 				idx: -1,
 			},
@@ -186,7 +199,7 @@ func TestList_At(t *testing.T) {
 		},
 		{
 			name: "non-empty list index is equal to size",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: &node[int]{
 						next: nil,
@@ -195,7 +208,7 @@ func TestList_At(t *testing.T) {
 					val: 11,
 				},
 				size: 2,
-			},
+			}),
 			args: args{
 				idx: 2,
 			},
@@ -204,7 +217,7 @@ func TestList_At(t *testing.T) {
 		},
 		{
 			name: "non-empty list index is greater than size",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: &node[int]{
 						next: nil,
@@ -213,7 +226,7 @@ func TestList_At(t *testing.T) {
 					val: 11,
 				},
 				size: 2,
-			},
+			}),
 			args: args{
 				idx: 3,
 			},
@@ -253,7 +266,7 @@ func TestList_PushFront(t *testing.T) {
 		},
 		{
 			name: "non-empty list",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: &node[int]{
 						next: nil,
@@ -262,7 +275,7 @@ func TestList_PushFront(t *testing.T) {
 					val: 11,
 				},
 				size: 2,
-			},
+			}),
 			args: args[int]{1},
 			want: 1,
 		},
@@ -319,7 +332,7 @@ func TestList_InsertAt(t *testing.T) {
 		},
 		{
 			name: "non-empty list 0 index",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: &node[int]{
 						next: nil,
@@ -328,7 +341,7 @@ func TestList_InsertAt(t *testing.T) {
 					val: 11,
 				},
 				size: 2,
-			},
+			}),
 			args: args[int]{
 				idx: 0,
 				t:   12,
@@ -339,7 +352,7 @@ func TestList_InsertAt(t *testing.T) {
 		},
 		{
 			name: "non-empty list",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: &node[int]{
 						next: nil,
@@ -348,7 +361,7 @@ func TestList_InsertAt(t *testing.T) {
 					val: 11,
 				},
 				size: 2,
-			},
+			}),
 			args: args[int]{
 				idx: 1,
 				t:   13,
@@ -397,7 +410,7 @@ func TestList_DeleteAt(t *testing.T) {
 		},
 		{
 			name: "index out of range",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: &node[int]{
 						next: nil,
@@ -406,7 +419,7 @@ func TestList_DeleteAt(t *testing.T) {
 					val: 11,
 				},
 				size: 2,
-			},
+			}),
 			args: args{
 				idx: 3,
 			},
@@ -416,7 +429,7 @@ func TestList_DeleteAt(t *testing.T) {
 		},
 		{
 			name: "non-empty list valid index",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: &node[int]{
 						next: &node[int]{
@@ -428,7 +441,7 @@ func TestList_DeleteAt(t *testing.T) {
 					val: 11,
 				},
 				size: 3,
-			},
+			}),
 			args: args{
 				idx: 1,
 			},
@@ -438,7 +451,7 @@ func TestList_DeleteAt(t *testing.T) {
 		},
 		{
 			name: "non-empty list zero index",
-			l: SLList[int]{
+			l: withTail(SLList[int]{
 				head: &node[int]{
 					next: &node[int]{
 						next: nil,
@@ -447,7 +460,7 @@ func TestList_DeleteAt(t *testing.T) {
 					val: 11,
 				},
 				size: 2,
-			},
+			}),
 			args: args{
 				idx: 0,
 			},
@@ -470,3 +483,373 @@ func TestList_DeleteAt(t *testing.T) {
 		})
 	}
 }
+
+func TestList_All(t *testing.T) {
+	type testCase[T any] struct {
+		name      string
+		l         SLList[T]
+		stopAfter int
+		wantIdx   []int
+		wantVals  []T
+	}
+	tests := []testCase[int]{
+		{
+			name:      "empty list",
+			l:         SLList[int]{},
+			stopAfter: -1,
+			wantIdx:   nil,
+			wantVals:  nil,
+		},
+		{
+			name: "full walk",
+			l: withTail(SLList[int]{
+				head: &node[int]{
+					next: &node[int]{
+						next: &node[int]{
+							next: nil,
+							val:  13,
+						},
+						val: 12,
+					},
+					val: 11,
+				},
+				size: 3,
+			}),
+			stopAfter: -1,
+			wantIdx:   []int{0, 1, 2},
+			wantVals:  []int{11, 12, 13},
+		},
+		{
+			name: "break early",
+			l: withTail(SLList[int]{
+				head: &node[int]{
+					next: &node[int]{
+						next: &node[int]{
+							next: nil,
+							val:  13,
+						},
+						val: 12,
+					},
+					val: 11,
+				},
+				size: 3,
+			}),
+			stopAfter: 2,
+			wantIdx:   []int{0, 1},
+			wantVals:  []int{11, 12},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var gotIdx, gotVals []int
+			for i, v := range tt.l.All() {
+				if len(gotIdx) == tt.stopAfter {
+					break
+				}
+				gotIdx = append(gotIdx, i)
+				gotVals = append(gotVals, v)
+			}
+			assert.Equal(t, tt.wantIdx, gotIdx)
+			assert.Equal(t, tt.wantVals, gotVals)
+		})
+	}
+}
+
+func TestList_Values(t *testing.T) {
+	type testCase[T any] struct {
+		name string
+		l    SLList[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "empty list",
+			l:    SLList[int]{},
+			want: nil,
+		},
+		{
+			name: "non-empty list",
+			l: withTail(SLList[int]{
+				head: &node[int]{
+					next: &node[int]{
+						next: nil,
+						val:  121,
+					},
+					val: 11,
+				},
+				size: 2,
+			}),
+			want: []int{11, 121},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []int
+			for v := range tt.l.Values() {
+				got = append(got, v)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestList_Each(t *testing.T) {
+	type testCase[T any] struct {
+		name     string
+		l        SLList[T]
+		stopAt   T
+		wantIdx  []int
+		wantVals []T
+	}
+	tests := []testCase[int]{
+		{
+			name:     "empty list",
+			l:        SLList[int]{},
+			stopAt:   0,
+			wantIdx:  nil,
+			wantVals: nil,
+		},
+		{
+			name: "full walk",
+			l: withTail(SLList[int]{
+				head: &node[int]{
+					next: &node[int]{
+						next: nil,
+						val:  121,
+					},
+					val: 11,
+				},
+				size: 2,
+			}),
+			stopAt:   -1,
+			wantIdx:  []int{0, 1},
+			wantVals: []int{11, 121},
+		},
+		{
+			name: "stop on match",
+			l: withTail(SLList[int]{
+				head: &node[int]{
+					next: &node[int]{
+						next: &node[int]{
+							next: nil,
+							val:  100,
+						},
+						val: 121,
+					},
+					val: 11,
+				},
+				size: 3,
+			}),
+			stopAt:   121,
+			wantIdx:  []int{0, 1},
+			wantVals: []int{11, 121},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var gotIdx, gotVals []int
+			tt.l.Each(func(idx int, v int) bool {
+				gotIdx = append(gotIdx, idx)
+				gotVals = append(gotVals, v)
+				return v != tt.stopAt
+			})
+			assert.Equal(t, tt.wantIdx, gotIdx)
+			assert.Equal(t, tt.wantVals, gotVals)
+		})
+	}
+}
+
+func TestList_PopFront(t *testing.T) {
+	type testCase[T any] struct {
+		name     string
+		l        SLList[T]
+		want     T
+		wantErr  error
+		wantBack T
+	}
+	tests := []testCase[int]{
+		{
+			name:    "empty list",
+			l:       SLList[int]{},
+			want:    0,
+			wantErr: ErrIndexIsOutOfSize,
+		},
+		{
+			name: "single element",
+			l: withTail(SLList[int]{
+				head: &node[int]{
+					next: nil,
+					val:  11,
+				},
+				size: 1,
+			}),
+			want:     11,
+			wantErr:  nil,
+			wantBack: 0,
+		},
+		{
+			name: "two elements",
+			l: withTail(SLList[int]{
+				head: &node[int]{
+					next: &node[int]{
+						next: nil,
+						val:  121,
+					},
+					val: 11,
+				},
+				size: 2,
+			}),
+			want:     11,
+			wantErr:  nil,
+			wantBack: 121,
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.l.PopFront()
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			back, _ := tt.l.PeekBack()
+			assert.Equal(t, tt.wantBack, back)
+		})
+	}
+}
+
+func TestList_PeekBack(t *testing.T) {
+	type testCase struct {
+		name    string
+		build   func(l *SLList[int])
+		want    int
+		wantErr error
+	}
+	tests := []testCase{
+		{
+			name:    "empty list",
+			build:   func(l *SLList[int]) {},
+			want:    0,
+			wantErr: ErrIndexIsOutOfSize,
+		},
+		{
+			name: "after insert front",
+			build: func(l *SLList[int]) {
+				l.InsertFront(2)
+				l.InsertFront(1)
+			},
+			want: 2,
+		},
+		{
+			name: "after insert at end",
+			build: func(l *SLList[int]) {
+				l.Insert(1)
+				_ = l.InsertAt(5, 3)
+				_ = l.InsertAt(1, 2)
+			},
+			want: 3,
+		},
+		{
+			name: "after deleting the last node",
+			build: func(l *SLList[int]) {
+				l.Insert(1)
+				l.Insert(2)
+				l.Insert(3)
+				_ = l.DeleteAt(2)
+			},
+			want: 2,
+		},
+		{
+			name: "after draining and refilling",
+			build: func(l *SLList[int]) {
+				l.Insert(1)
+				_, _ = l.PopFront()
+				l.Insert(7)
+			},
+			want: 7,
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := SLList[int]{}
+			tt.build(&l)
+			got, err := l.PeekBack()
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func BenchmarkList_Insert(b *testing.B) {
+	for _, size := range []int{1_000, 10_000, 100_000} {
+		b.Run(fmt.Sprintf("build_%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				l := SLList[int]{}
+				for j := 0; j < size; j++ {
+					l.Insert(j)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkList_Queue(b *testing.B) {
+	l := SLList[int]{}
+	for i := 0; i < 1_000; i++ {
+		l.Insert(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Insert(i)
+		_, _ = l.PopFront()
+	}
+}
+
+func TestList_ToSlice(t *testing.T) {
+	type testCase struct {
+		name string
+		in   []int
+		want []int
+	}
+	tests := []testCase{
+		{
+			name: "empty slice",
+			in:   nil,
+			want: []int{},
+		},
+		{
+			name: "non-empty slice",
+			in:   []int{11, 121, 100},
+			want: []int{11, 121, 100},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := FromSlice(tt.in)
+			assert.Equal(t, len(tt.in), l.Size())
+			assert.Equal(t, tt.want, l.ToSlice())
+			assert.Equal(t, tt.want, From(l.Values()).ToSlice())
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package sll
+
+// RemoveFunc deletes every value satisfying pred in a single pass and
+// returns how many values were removed.
+func (l *SLList[T]) RemoveFunc(pred func(v T) bool) int {
+	removed := 0
+
+	var prev *node[T]
+	for current := l.head; current != nil; current = current.next {
+		if !pred(current.val) {
+			prev = current
+			continue
+		}
+
+		if prev == nil {
+			l.head = current.next
+		} else {
+			prev.next = current.next
+		}
+		removed++
+	}
+
+	l.tail = prev
+	l.size -= removed
+
+	return removed
+}
+
+// Retain keeps only the values satisfying pred and returns how many values
+// were removed.
+func (l *SLList[T]) Retain(pred func(v T) bool) int {
+	return l.RemoveFunc(func(v T) bool { return !pred(v) })
+}
+
+// Clear removes all values from the list.
+func (l *SLList[T]) Clear() {
+	l.head, l.tail, l.size = nil, nil, 0
+}
+
+// Truncate keeps the first n values and drops the rest. A n bigger than
+// the list size leaves the list untouched.
+func (l *SLList[T]) Truncate(n int) error {
+	if n < 0 {
+		return ErrIndexIsOutOfSize
+	}
+
+	if n >= l.size {
+		return nil
+	}
+
+	if n == 0 {
+		l.Clear()
+		return nil
+	}
+
+	l.tail = l.getNodeByIdx(n - 1)
+	l.tail.next = nil
+	l.size = n
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package sll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_RemoveFunc(t *testing.T) {
+	type testCase struct {
+		name        string
+		in          []int
+		pred        func(v int) bool
+		wantRemoved int
+		want        []int
+	}
+	tests := []testCase{
+		{
+			name:        "empty list",
+			in:          nil,
+			pred:        func(v int) bool { return true },
+			wantRemoved: 0,
+			want:        []int{},
+		},
+		{
+			name:        "remove all",
+			in:          []int{1, 2, 3},
+			pred:        func(v int) bool { return true },
+			wantRemoved: 3,
+			want:        []int{},
+		},
+		{
+			name:        "remove head and tail",
+			in:          []int{0, 1, 2, 3, 4},
+			pred:        func(v int) bool { return v == 0 || v == 4 },
+			wantRemoved: 2,
+			want:        []int{1, 2, 3},
+		},
+		{
+			name:        "remove even",
+			in:          []int{1, 2, 2, 3, 4, 5},
+			pred:        func(v int) bool { return v%2 == 0 },
+			wantRemoved: 3,
+			want:        []int{1, 3, 5},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := FromSlice(tt.in)
+			assert.Equal(t, tt.wantRemoved, l.RemoveFunc(tt.pred))
+			assert.Equal(t, tt.want, l.ToSlice())
+			assert.Equal(t, len(tt.want), l.Size())
+
+			l.Insert(100)
+			back, _ := l.PeekBack()
+			assert.Equal(t, 100, back)
+		})
+	}
+}
+
+func TestList_Retain(t *testing.T) {
+	t.Parallel()
+
+	l := FromSlice([]int{1, 2, 3, 4, 5})
+	assert.Equal(t, 3, l.Retain(func(v int) bool { return v%2 == 0 }))
+	assert.Equal(t, []int{2, 4}, l.ToSlice())
+}
+
+func TestList_Clear(t *testing.T) {
+	t.Parallel()
+
+	l := FromSlice([]int{1, 2, 3})
+	l.Clear()
+	assert.True(t, l.IsEmpty())
+	assert.Equal(t, 0, l.Size())
+
+	l.Insert(4)
+	assert.Equal(t, []int{4}, l.ToSlice())
+}
+
+func TestList_Truncate(t *testing.T) {
+	type testCase struct {
+		name    string
+		in      []int
+		n       int
+		want    []int
+		wantErr error
+	}
+	tests := []testCase{
+		{
+			name:    "negative size",
+			in:      []int{1, 2},
+			n:       -1,
+			want:    []int{1, 2},
+			wantErr: ErrIndexIsOutOfSize,
+		},
+		{
+			name: "bigger than size",
+			in:   []int{1, 2},
+			n:    5,
+			want: []int{1, 2},
+		},
+		{
+			name: "to zero",
+			in:   []int{1, 2},
+			n:    0,
+			want: []int{},
+		},
+		{
+			name: "in the middle",
+			in:   []int{1, 2, 3, 4},
+			n:    2,
+			want: []int{1, 2},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := FromSlice(tt.in)
+			assert.ErrorIs(t, l.Truncate(tt.n), tt.wantErr)
+			assert.Equal(t, tt.want, l.ToSlice())
+			assert.Equal(t, len(tt.want), l.Size())
+
+			l.Insert(100)
+			back, _ := l.PeekBack()
+			assert.Equal(t, 100, back)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package sll
+
+// IndexFunc returns the index of the first value satisfying pred, or -1.
+func (l *SLList[T]) IndexFunc(pred func(v T) bool) int {
+	idx := 0
+	for current := l.head; current != nil; current = current.next {
+		if pred(current.val) {
+			return idx
+		}
+		idx++
+	}
+	return -1
+}
+
+// Find returns the first value satisfying pred.
+func (l *SLList[T]) Find(pred func(v T) bool) (T, bool) {
+	for current := l.head; current != nil; current = current.next {
+		if pred(current.val) {
+			return current.val, true
+		}
+	}
+
+	var tNil T
+	return tNil, false
+}
+
+// ContainsFunc reports whether at least one value satisfies pred.
+func (l *SLList[T]) ContainsFunc(pred func(v T) bool) bool {
+	return l.IndexFunc(pred) >= 0
+}
+
+// Index returns the index of the first occurrence of v in l, or -1.
+func Index[T comparable](l *SLList[T], v T) int {
+	return l.IndexFunc(func(x T) bool { return x == v })
+}
+
+// Contains reports whether v is present in l.
+func Contains[T comparable](l *SLList[T], v T) bool {
+	return Index(l, v) >= 0
+}
@@ -0,0 +1,68 @@
+package sll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_IndexFunc(t *testing.T) {
+	type testCase struct {
+		name      string
+		in        []int
+		pred      func(v int) bool
+		wantIdx   int
+		wantFound int
+		wantOk    bool
+	}
+	tests := []testCase{
+		{
+			name:      "empty list",
+			in:        nil,
+			pred:      func(v int) bool { return true },
+			wantIdx:   -1,
+			wantFound: 0,
+			wantOk:    false,
+		},
+		{
+			name:      "first match wins",
+			in:        []int{1, 4, 6, 8},
+			pred:      func(v int) bool { return v%2 == 0 },
+			wantIdx:   1,
+			wantFound: 4,
+			wantOk:    true,
+		},
+		{
+			name:      "no match",
+			in:        []int{1, 3, 5},
+			pred:      func(v int) bool { return v > 10 },
+			wantIdx:   -1,
+			wantFound: 0,
+			wantOk:    false,
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := FromSlice(tt.in)
+			assert.Equal(t, tt.wantIdx, l.IndexFunc(tt.pred))
+			found, ok := l.Find(tt.pred)
+			assert.Equal(t, tt.wantFound, found)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantOk, l.ContainsFunc(tt.pred))
+		})
+	}
+}
+
+func TestIndex(t *testing.T) {
+	t.Parallel()
+
+	l := FromSlice([]string{"a", "b", "c", "b"})
+	assert.Equal(t, 1, Index(l, "b"))
+	assert.Equal(t, -1, Index(l, "z"))
+	assert.True(t, Contains(l, "c"))
+	assert.False(t, Contains(l, "z"))
+}
@@ -0,0 +1,84 @@
+package sll
+
+import "cmp"
+
+// SortFunc sorts the list in place with a stable merge sort over the nodes.
+// compare must return a negative number when a < b, a positive number when
+// a > b and zero when they are equal.
+func (l *SLList[T]) SortFunc(compare func(a, b T) int) {
+	if l.size < 2 {
+		return
+	}
+
+	l.head = mergeSort(l.head, compare)
+
+	current := l.head
+	for current.next != nil {
+		current = current.next
+	}
+	l.tail = current
+}
+
+// IsSortedFunc reports whether the list is sorted according to compare.
+func (l *SLList[T]) IsSortedFunc(compare func(a, b T) int) bool {
+	if l.head == nil {
+		return true
+	}
+
+	for current := l.head; current.next != nil; current = current.next {
+		if compare(current.next.val, current.val) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Sort sorts l in ascending order.
+func Sort[T cmp.Ordered](l *SLList[T]) {
+	l.SortFunc(cmp.Compare[T])
+}
+
+// IsSorted reports whether l is sorted in ascending order.
+func IsSorted[T cmp.Ordered](l *SLList[T]) bool {
+	return l.IsSortedFunc(cmp.Compare[T])
+}
+
+func mergeSort[T any](head *node[T], compare func(a, b T) int) *node[T] {
+	if head == nil || head.next == nil {
+		return head
+	}
+
+	slow, fast := head, head.next
+	for fast != nil && fast.next != nil {
+		slow, fast = slow.next, fast.next.next
+	}
+
+	right := slow.next
+	slow.next = nil
+
+	return merge(mergeSort(head, compare), mergeSort(right, compare), compare)
+}
+
+func merge[T any](left, right *node[T], compare func(a, b T) int) *node[T] {
+	var dummy node[T]
+	current := &dummy
+
+	for left != nil && right != nil {
+		// Taking from the left run on ties keeps the sort stable.
+		if compare(right.val, left.val) < 0 {
+			current.next, right = right, right.next
+		} else {
+			current.next, left = left, left.next
+		}
+		current = current.next
+	}
+
+	if left != nil {
+		current.next = left
+	} else {
+		current.next = right
+	}
+
+	return dummy.next
+}
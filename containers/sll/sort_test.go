@@ -0,0 +1,88 @@
+package sll
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_Sort(t *testing.T) {
+	type testCase struct {
+		name string
+		in   []int
+		want []int
+	}
+	tests := []testCase{
+		{
+			name: "empty list",
+			in:   nil,
+			want: nil,
+		},
+		{
+			name: "single element",
+			in:   []int{1},
+			want: []int{1},
+		},
+		{
+			name: "reversed",
+			in:   []int{5, 4, 3, 2, 1},
+			want: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name: "with duplicates",
+			in:   []int{3, 1, 2, 3, 1, 0},
+			want: []int{0, 1, 1, 2, 3, 3},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			l := SLList[int]{}
+			for _, v := range tt.in {
+				l.Insert(v)
+			}
+
+			Sort(&l)
+
+			var got []int
+			for v := range l.Values() {
+				got = append(got, v)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.True(t, IsSorted(&l))
+			assert.Equal(t, len(tt.in), l.Size())
+			if len(tt.want) > 0 {
+				back, _ := l.PeekBack()
+				assert.Equal(t, tt.want[len(tt.want)-1], back)
+			}
+		})
+	}
+}
+
+func TestList_SortFunc(t *testing.T) {
+	t.Parallel()
+
+	type pair struct {
+		key int
+		pos int
+	}
+	l := SLList[pair]{}
+	for i, k := range []int{2, 1, 2, 1, 0, 2} {
+		l.Insert(pair{key: k, pos: i})
+	}
+
+	byKey := func(a, b pair) int { return a.key - b.key }
+	assert.False(t, l.IsSortedFunc(byKey))
+
+	l.SortFunc(byKey)
+
+	var got []pair
+	for v := range l.Values() {
+		got = append(got, v)
+	}
+	assert.Equal(t, []pair{{0, 4}, {1, 1}, {1, 3}, {2, 0}, {2, 2}, {2, 5}}, got)
+	assert.True(t, l.IsSortedFunc(byKey))
+}
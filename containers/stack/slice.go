@@ -0,0 +1,42 @@
+package stack
+
+// Slice is a LIFO stack backed by a slice.
+// The zero value is an empty stack ready to use.
+type Slice[T any] struct {
+	items []T
+}
+
+// NewSlice returns a Slice with room for capacity values.
+func NewSlice[T any](capacity int) *Slice[T] {
+	return &Slice[T]{items: make([]T, 0, capacity)}
+}
+
+func (s *Slice[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+func (s *Slice[T]) Pop() (T, bool) {
+	var tNil T
+	if len(s.items) == 0 {
+		return tNil, false
+	}
+
+	last := len(s.items) - 1
+	v := s.items[last]
+	s.items[last] = tNil
+	s.items = s.items[:last]
+
+	return v, true
+}
+
+func (s *Slice[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var tNil T
+		return tNil, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+func (s *Slice[T]) Len() int {
+	return len(s.items)
+}
@@ -0,0 +1,27 @@
+package stack
+
+import "github.com/ivdaria/go-containers/containers/sll"
+
+// Stack is a LIFO stack backed by a singly linked list.
+// The zero value is an empty stack ready to use.
+type Stack[T any] struct {
+	list sll.SLList[T]
+}
+
+func (s *Stack[T]) Push(v T) {
+	s.list.InsertFront(v)
+}
+
+func (s *Stack[T]) Pop() (T, bool) {
+	v, err := s.list.PopFront()
+	return v, err == nil
+}
+
+func (s *Stack[T]) Peek() (T, bool) {
+	v, err := s.list.At(0)
+	return v, err == nil
+}
+
+func (s *Stack[T]) Len() int {
+	return s.list.Size()
+}
@@ -0,0 +1,95 @@
+package stack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers"
+)
+
+var (
+	_ containers.Stack[int] = (*Stack[int])(nil)
+	_ containers.Stack[int] = (*Slice[int])(nil)
+)
+
+var implementations = []struct {
+	name string
+	new  func() containers.Stack[int]
+}{
+	{
+		name: "linked",
+		new:  func() containers.Stack[int] { return &Stack[int]{} },
+	},
+	{
+		name: "slice",
+		new:  func() containers.Stack[int] { return NewSlice[int](0) },
+	},
+}
+
+func TestStack(t *testing.T) {
+	type testCase struct {
+		name     string
+		push     []int
+		pops     int
+		want     []int
+		wantPeek int
+		wantOk   bool
+		wantLen  int
+	}
+	tests := []testCase{
+		{
+			name:     "empty stack",
+			push:     nil,
+			pops:     1,
+			want:     []int{0},
+			wantPeek: 0,
+			wantOk:   false,
+			wantLen:  0,
+		},
+		{
+			name:     "last in first out",
+			push:     []int{1, 2, 3},
+			pops:     2,
+			want:     []int{3, 2},
+			wantPeek: 1,
+			wantOk:   true,
+			wantLen:  1,
+		},
+		{
+			name:     "drain",
+			push:     []int{1, 2},
+			pops:     2,
+			want:     []int{2, 1},
+			wantPeek: 0,
+			wantOk:   false,
+			wantLen:  0,
+		},
+	}
+
+	t.Parallel()
+	for _, impl := range implementations {
+		for _, tt := range tests {
+			impl, tt := impl, tt
+			t.Run(impl.name+"/"+tt.name, func(t *testing.T) {
+				t.Parallel()
+				s := impl.new()
+				for _, v := range tt.push {
+					s.Push(v)
+				}
+
+				var got []int
+				for i := 0; i < tt.pops; i++ {
+					v, _ := s.Pop()
+					got = append(got, v)
+				}
+				assert.Equal(t, tt.want, got)
+
+				peek, ok := s.Peek()
+				assert.Equal(t, tt.wantPeek, peek)
+				assert.Equal(t, tt.wantOk, ok)
+				assert.Equal(t, tt.wantLen, s.Len())
+			})
+		}
+	}
+}
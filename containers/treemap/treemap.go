@@ -0,0 +1,333 @@
+package treemap
+
+import (
+	"cmp"
+	"iter"
+)
+
+type node[K, V any] struct {
+	left   *node[K, V]
+	right  *node[K, V]
+	key    K
+	val    V
+	height int
+	size   int
+}
+
+func height[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func size[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// TreeMap is a sorted map backed by an AVL tree. Every node also keeps the
+// size of its subtree, so Rank and Select run in O(log n) as well.
+// The zero value is not usable; create one with New or NewOrdered.
+type TreeMap[K, V any] struct {
+	root *node[K, V]
+	cmp  func(a, b K) int
+}
+
+// New returns an empty map ordered by compare, which must return a negative
+// number when a < b, a positive number when a > b and zero when they are
+// equal.
+func New[K, V any](compare func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{cmp: compare}
+}
+
+// NewOrdered returns an empty map ordered by the natural order of K.
+func NewOrdered[K cmp.Ordered, V any]() *TreeMap[K, V] {
+	return New[K, V](cmp.Compare[K])
+}
+
+func (m *TreeMap[K, V]) Len() int {
+	return size(m.root)
+}
+
+// Put stores val under key, replacing the previous value if any.
+func (m *TreeMap[K, V]) Put(key K, val V) {
+	m.root = m.put(m.root, key, val)
+}
+
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	n := m.root
+	for n != nil {
+		switch c := m.cmp(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.val, true
+		}
+	}
+
+	var vNil V
+	return vNil, false
+}
+
+// Delete removes key and reports whether it was present.
+func (m *TreeMap[K, V]) Delete(key K) bool {
+	var deleted bool
+	m.root, deleted = m.delete(m.root, key)
+	return deleted
+}
+
+// Floor returns the greatest entry with a key less than or equal to key.
+func (m *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	var found *node[K, V]
+
+	n := m.root
+	for n != nil {
+		switch c := m.cmp(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			found, n = n, n.right
+		default:
+			return unpack(n)
+		}
+	}
+
+	return unpack(found)
+}
+
+// Ceiling returns the least entry with a key greater than or equal to key.
+func (m *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	var found *node[K, V]
+
+	n := m.root
+	for n != nil {
+		switch c := m.cmp(key, n.key); {
+		case c < 0:
+			found, n = n, n.left
+		case c > 0:
+			n = n.right
+		default:
+			return unpack(n)
+		}
+	}
+
+	return unpack(found)
+}
+
+func (m *TreeMap[K, V]) Min() (K, V, bool) {
+	if m.root == nil {
+		return unpack[K, V](nil)
+	}
+	return unpack(minNode(m.root))
+}
+
+func (m *TreeMap[K, V]) Max() (K, V, bool) {
+	n := m.root
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+	return unpack(n)
+}
+
+// Rank returns the number of keys strictly less than key.
+func (m *TreeMap[K, V]) Rank(key K) int {
+	rank := 0
+
+	n := m.root
+	for n != nil {
+		switch c := m.cmp(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += size(n.left) + 1
+			n = n.right
+		default:
+			return rank + size(n.left)
+		}
+	}
+
+	return rank
+}
+
+// Select returns the entry with the given zero-based rank in key order.
+func (m *TreeMap[K, V]) Select(rank int) (K, V, bool) {
+	if rank < 0 || rank >= m.Len() {
+		return unpack[K, V](nil)
+	}
+
+	n := m.root
+	for {
+		left := size(n.left)
+		switch {
+		case rank < left:
+			n = n.left
+		case rank > left:
+			rank -= left + 1
+			n = n.right
+		default:
+			return unpack(n)
+		}
+	}
+}
+
+// All returns an iterator over all entries in ascending key order.
+func (m *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		walk(m.root, yield)
+	}
+}
+
+// Range returns an iterator over the entries with lo <= key < hi in
+// ascending key order.
+func (m *TreeMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.walkRange(m.root, lo, hi, yield)
+	}
+}
+
+func walk[K, V any](n *node[K, V], yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return walk(n.left, yield) && yield(n.key, n.val) && walk(n.right, yield)
+}
+
+func (m *TreeMap[K, V]) walkRange(n *node[K, V], lo, hi K, yield func(K, V) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	aboveLo := m.cmp(lo, n.key) <= 0
+	belowHi := m.cmp(n.key, hi) < 0
+
+	if aboveLo && !m.walkRange(n.left, lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.key, n.val) {
+		return false
+	}
+	if belowHi {
+		return m.walkRange(n.right, lo, hi, yield)
+	}
+	return true
+}
+
+func (m *TreeMap[K, V]) put(n *node[K, V], key K, val V) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{key: key, val: val, height: 1, size: 1}
+	}
+
+	switch c := m.cmp(key, n.key); {
+	case c < 0:
+		n.left = m.put(n.left, key, val)
+	case c > 0:
+		n.right = m.put(n.right, key, val)
+	default:
+		n.val = val
+		return n
+	}
+
+	return rebalance(n)
+}
+
+func (m *TreeMap[K, V]) delete(n *node[K, V], key K) (*node[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var deleted bool
+	switch c := m.cmp(key, n.key); {
+	case c < 0:
+		n.left, deleted = m.delete(n.left, key)
+	case c > 0:
+		n.right, deleted = m.delete(n.right, key)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+
+		succ := minNode(n.right)
+		n.right = deleteMin(n.right)
+		succ.left, succ.right = n.left, n.right
+		return rebalance(succ), true
+	}
+
+	if !deleted {
+		return n, false
+	}
+	return rebalance(n), true
+}
+
+func minNode[K, V any](n *node[K, V]) *node[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func deleteMin[K, V any](n *node[K, V]) *node[K, V] {
+	if n.left == nil {
+		return n.right
+	}
+	n.left = deleteMin(n.left)
+	return rebalance(n)
+}
+
+func update[K, V any](n *node[K, V]) {
+	n.height = max(height(n.left), height(n.right)) + 1
+	n.size = size(n.left) + size(n.right) + 1
+}
+
+func rotateLeft[K, V any](n *node[K, V]) *node[K, V] {
+	r := n.right
+	n.right, r.left = r.left, n
+	update(n)
+	update(r)
+	return r
+}
+
+func rotateRight[K, V any](n *node[K, V]) *node[K, V] {
+	l := n.left
+	n.left, l.right = l.right, n
+	update(n)
+	update(l)
+	return l
+}
+
+func rebalance[K, V any](n *node[K, V]) *node[K, V] {
+	update(n)
+
+	switch balance := height(n.left) - height(n.right); {
+	case balance > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case balance < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+
+	return n
+}
+
+func unpack[K, V any](n *node[K, V]) (K, V, bool) {
+	if n == nil {
+		var (
+			kNil K
+			vNil V
+		)
+		return kNil, vNil, false
+	}
+	return n.key, n.val, true
+}
@@ -0,0 +1,275 @@
+package treemap
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ivdaria/go-containers/containers"
+)
+
+var _ containers.SortedMap[int, string] = (*TreeMap[int, string])(nil)
+
+// checkTree verifies the AVL balance, the cached heights and sizes and the
+// key order of every node and returns the height of n.
+func checkTree[K, V any](t *testing.T, m *TreeMap[K, V], n *node[K, V]) int {
+	t.Helper()
+
+	if n == nil {
+		return 0
+	}
+
+	if n.left != nil {
+		assert.Negative(t, m.cmp(n.left.key, n.key))
+	}
+	if n.right != nil {
+		assert.Positive(t, m.cmp(n.right.key, n.key))
+	}
+
+	lh, rh := checkTree(t, m, n.left), checkTree(t, m, n.right)
+	assert.LessOrEqual(t, lh-rh, 1)
+	assert.GreaterOrEqual(t, lh-rh, -1)
+	assert.Equal(t, max(lh, rh)+1, n.height)
+	assert.Equal(t, size(n.left)+size(n.right)+1, n.size)
+
+	return n.height
+}
+
+func keys(m *TreeMap[int, string]) []int {
+	var got []int
+	for k := range m.All() {
+		got = append(got, k)
+	}
+	return got
+}
+
+func newFrom(keys ...int) *TreeMap[int, string] {
+	m := NewOrdered[int, string]()
+	for _, k := range keys {
+		m.Put(k, "")
+	}
+	return m
+}
+
+func TestTreeMap_PutGetDelete(t *testing.T) {
+	t.Parallel()
+
+	m := NewOrdered[string, int]()
+	_, ok := m.Get("a")
+	assert.False(t, ok)
+
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("c", 3)
+	m.Put("a", 10)
+
+	v, ok := m.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
+	assert.Equal(t, 3, m.Len())
+
+	assert.True(t, m.Delete("b"))
+	assert.False(t, m.Delete("b"))
+	_, ok = m.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 2, m.Len())
+}
+
+func TestTreeMap_FloorCeiling(t *testing.T) {
+	type testCase struct {
+		name        string
+		key         int
+		wantFloor   int
+		wantFloorOk bool
+		wantCeil    int
+		wantCeilOk  bool
+	}
+	tests := []testCase{
+		{
+			name:        "below min",
+			key:         5,
+			wantFloorOk: false,
+			wantCeil:    10,
+			wantCeilOk:  true,
+		},
+		{
+			name:        "exact match",
+			key:         20,
+			wantFloor:   20,
+			wantFloorOk: true,
+			wantCeil:    20,
+			wantCeilOk:  true,
+		},
+		{
+			name:        "between keys",
+			key:         25,
+			wantFloor:   20,
+			wantFloorOk: true,
+			wantCeil:    30,
+			wantCeilOk:  true,
+		},
+		{
+			name:        "above max",
+			key:         50,
+			wantFloor:   40,
+			wantFloorOk: true,
+			wantCeilOk:  false,
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newFrom(30, 10, 40, 20)
+
+			k, _, ok := m.Floor(tt.key)
+			assert.Equal(t, tt.wantFloorOk, ok)
+			assert.Equal(t, tt.wantFloor, k)
+
+			k, _, ok = m.Ceiling(tt.key)
+			assert.Equal(t, tt.wantCeilOk, ok)
+			assert.Equal(t, tt.wantCeil, k)
+		})
+	}
+}
+
+func TestTreeMap_MinMax(t *testing.T) {
+	t.Parallel()
+
+	m := NewOrdered[int, string]()
+	_, _, ok := m.Min()
+	assert.False(t, ok)
+	_, _, ok = m.Max()
+	assert.False(t, ok)
+
+	m.Put(2, "b")
+	m.Put(1, "a")
+	m.Put(3, "c")
+
+	k, v, ok := m.Min()
+	assert.Equal(t, 1, k)
+	assert.Equal(t, "a", v)
+	assert.True(t, ok)
+
+	k, v, ok = m.Max()
+	assert.Equal(t, 3, k)
+	assert.Equal(t, "c", v)
+	assert.True(t, ok)
+}
+
+func TestTreeMap_Range(t *testing.T) {
+	type testCase struct {
+		name   string
+		lo, hi int
+		want   []int
+	}
+	tests := []testCase{
+		{
+			name: "empty range",
+			lo:   5,
+			hi:   5,
+			want: nil,
+		},
+		{
+			name: "hi is exclusive",
+			lo:   2,
+			hi:   6,
+			want: []int{2, 3, 4, 5},
+		},
+		{
+			name: "bounds outside the keys",
+			lo:   -10,
+			hi:   100,
+			want: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+	}
+
+	t.Parallel()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m := newFrom(5, 2, 8, 0, 9, 3, 7, 1, 6, 4)
+
+			var got []int
+			for k := range m.Range(tt.lo, tt.hi) {
+				got = append(got, k)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("break early", func(t *testing.T) {
+		t.Parallel()
+		m := newFrom(5, 2, 8, 0, 9, 3, 7, 1, 6, 4)
+
+		var got []int
+		for k := range m.Range(3, 9) {
+			if k == 6 {
+				break
+			}
+			got = append(got, k)
+		}
+		assert.Equal(t, []int{3, 4, 5}, got)
+	})
+}
+
+func TestTreeMap_RankSelect(t *testing.T) {
+	t.Parallel()
+
+	m := newFrom(50, 10, 40, 20, 30)
+
+	for i, k := range []int{10, 20, 30, 40, 50} {
+		assert.Equal(t, i, m.Rank(k))
+
+		got, _, ok := m.Select(i)
+		assert.True(t, ok)
+		assert.Equal(t, k, got)
+	}
+
+	assert.Equal(t, 0, m.Rank(5))
+	assert.Equal(t, 2, m.Rank(25))
+	assert.Equal(t, 5, m.Rank(60))
+
+	_, _, ok := m.Select(-1)
+	assert.False(t, ok)
+	_, _, ok = m.Select(5)
+	assert.False(t, ok)
+}
+
+func TestTreeMap_Random(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	m := NewOrdered[int, string]()
+	ref := make(map[int]bool)
+
+	for i := 0; i < 5_000; i++ {
+		k := r.Intn(500)
+		if r.Intn(3) == 0 {
+			assert.Equal(t, ref[k], m.Delete(k))
+			delete(ref, k)
+		} else {
+			m.Put(k, "")
+			ref[k] = true
+		}
+
+		if i%500 == 0 {
+			checkTree(t, m, m.root)
+		}
+	}
+
+	checkTree(t, m, m.root)
+
+	want := make([]int, 0, len(ref))
+	for k := range ref {
+		want = append(want, k)
+	}
+	slices.Sort(want)
+	assert.Equal(t, want, keys(m))
+	assert.Equal(t, len(want), m.Len())
+}
@@ -1,12 +1,82 @@
 package containers
 
+import "iter"
+
+// ReadOnlyList is the read side of List, implemented by immutable
+// sequences as well.
+type ReadOnlyList[T any] interface {
+	At(idx int) (T, error)
+	Size() int
+	IsEmpty() bool
+}
+
 type List[T any] interface {
+	ReadOnlyList[T]
 	Insert(elem T)
+	// Deprecated: use Each or All.
 	Traverse(f func(v any))
-	IsEmpty() bool
-	Size() int
-	At(idx int) (T, error)
+	Each(f func(idx int, v T) bool)
+	All() iter.Seq2[int, T]
+	Values() iter.Seq[T]
+	ToSlice() []T
 	DeleteAt(idx int) error
 	InsertFront(t T)
 	InsertAt(idx int, t T) error
+	RemoveFunc(pred func(v T) bool) int
+	Retain(pred func(v T) bool) int
+	Clear()
+	Truncate(n int) error
+}
+
+type Deque[T any] interface {
+	PushFront(v T)
+	PushBack(v T)
+	PopFront() (T, bool)
+	PopBack() (T, bool)
+	PeekFront() (T, bool)
+	PeekBack() (T, bool)
+	Len() int
+}
+
+type Stack[T any] interface {
+	Push(v T)
+	Pop() (T, bool)
+	Peek() (T, bool)
+	Len() int
+}
+
+type Queue[T any] interface {
+	Push(v T)
+	Pop() (T, bool)
+	Peek() (T, bool)
+	Len() int
+}
+
+type PriorityQueue[T any] interface {
+	Push(v T)
+	Pop() (T, bool)
+	Peek() (T, bool)
+	Len() int
+}
+
+type IndexedPriorityQueue[K comparable, P any] interface {
+	Upsert(key K, priority P)
+	DecreaseKey(key K, priority P) error
+	Delete(key K) bool
+	Contains(key K) bool
+	PopMin() (K, P, bool)
+	Len() int
+}
+
+type SortedMap[K, V any] interface {
+	Put(key K, val V)
+	Get(key K) (V, bool)
+	Delete(key K) bool
+	Floor(key K) (K, V, bool)
+	Ceiling(key K) (K, V, bool)
+	Min() (K, V, bool)
+	Max() (K, V, bool)
+	Range(lo, hi K) iter.Seq2[K, V]
+	All() iter.Seq2[K, V]
+	Len() int
 }